@@ -0,0 +1,228 @@
+package cliz
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// ArgType is the type of the positional argument.
+type ArgType int
+
+const (
+	// ArgTypeString is the string positional argument. It is the default.
+	ArgTypeString ArgType = iota
+	// ArgTypeInt64 is the positional argument parsed by strconv.ParseInt.
+	ArgTypeInt64
+	// ArgTypeUint64 is the positional argument parsed by strconv.ParseUint.
+	ArgTypeUint64
+	// ArgTypeFloat64 is the positional argument parsed by strconv.ParseFloat.
+	ArgTypeFloat64
+	// ArgTypeBool is the positional argument parsed by strconv.ParseBool.
+	ArgTypeBool
+	// ArgTypeDuration is the positional argument parsed by time.ParseDuration.
+	ArgTypeDuration
+)
+
+func (t ArgType) String() string {
+	switch t {
+	case ArgTypeString:
+		return "string"
+	case ArgTypeInt64:
+		return "int64"
+	case ArgTypeUint64:
+		return "uint64"
+	case ArgTypeFloat64:
+		return "float64"
+	case ArgTypeBool:
+		return "bool"
+	case ArgTypeDuration:
+		return "duration"
+	default:
+		return "ArgType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+func (t ArgType) validate(s string) error {
+	var err error
+	switch t {
+	case ArgTypeString:
+	case ArgTypeInt64:
+		_, err = strconv.ParseInt(s, 10, 64)
+	case ArgTypeUint64:
+		_, err = strconv.ParseUint(s, 10, 64)
+	case ArgTypeFloat64:
+		_, err = strconv.ParseFloat(s, 64)
+	case ArgTypeBool:
+		_, err = strconv.ParseBool(s)
+	case ArgTypeDuration:
+		_, err = time.ParseDuration(s)
+	}
+	if err != nil {
+		return errorz.Errorf("%s: %w", t, err)
+	}
+	return nil
+}
+
+// Arg is the specification of the positional argument.
+type Arg struct {
+	// Name is the name of the argument.
+	Name string
+	// Description is the description of the argument.
+	Description string
+	// Required is the required flag of the argument.
+	Required bool
+	// Variadic is the flag that the argument takes all the rest of the arguments. Only the last argument can be variadic.
+	Variadic bool
+	// Type is the type of the argument. The argument is validated by the type in Parse.
+	Type ArgType
+	// CompleteFunc returns the candidate values of the argument for shell completion.
+	//
+	// toComplete is the word under the cursor, which may be empty.
+	CompleteFunc func(c *Command, toComplete string) (candidates []string)
+}
+
+func (a *Arg) GetDescription() string {
+	if a.Description != "" {
+		return a.Description
+	}
+	return a.Type.String() + " value of " + a.Name
+}
+
+// usage returns the argument in the usage line, like `<src>`, `[<dst>]` or `<file>...`.
+func (a *Arg) usage() string {
+	s := "<" + a.Name + ">"
+	if a.Variadic {
+		s += "..."
+	}
+	if !a.Required {
+		s = "[" + s + "]"
+	}
+	return s
+}
+
+func (c *Command) preCheckArgs() error {
+	names := make([]string, 0, len(c.Args))
+	for i, arg := range c.Args {
+		if arg.Name == "" {
+			return errorz.Errorf("%s: arg[%d]: name is empty: %w", c.Name, i, ErrInvalidArgSpec)
+		}
+		if slices.Contains(names, arg.Name) {
+			return errorz.Errorf("%s: arg: %s: duplicate name: %w", c.Name, arg.Name, ErrInvalidArgSpec)
+		}
+		names = append(names, arg.Name)
+		if arg.Variadic && i != len(c.Args)-1 {
+			return errorz.Errorf("%s: arg: %s: only the last argument can be variadic: %w", c.Name, arg.Name, ErrInvalidArgSpec)
+		}
+		if arg.Required && i > 0 && !c.Args[i-1].Required {
+			return errorz.Errorf("%s: arg: %s: required argument after optional argument: %w", c.Name, arg.Name, ErrInvalidArgSpec)
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if err := subcmd.preCheckArgs(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postCheckArgs validates the remaining arguments by Args of the executed command.
+func (c *Command) postCheckArgs(args []string) error {
+	executed := c.GetExecutedCommand()
+	if executed == nil || executed.Args == nil {
+		return nil
+	}
+
+	if err := executed.loadArgs(args); err != nil {
+		return errorz.Errorf("%s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return nil
+}
+
+func (c *Command) loadArgs(args []string) error {
+	values := make(map[string][]string, len(c.Args))
+	i := 0
+	for _, arg := range c.Args {
+		n := 1
+		if arg.Variadic {
+			n = len(args) - i
+		}
+		if i+n > len(args) || n == 0 {
+			if arg.Required {
+				return errorz.Errorf("arg: %s: %w", arg.usage(), ErrMissingArgument)
+			}
+			values[arg.Name] = []string{}
+			continue
+		}
+
+		for _, s := range args[i : i+n] {
+			if err := arg.Type.validate(s); err != nil {
+				return errorz.Errorf("arg: %s: %v: %w", arg.usage(), err, ErrInvalidArgument) //nolint:errorlint
+			}
+		}
+		values[arg.Name] = args[i : i+n]
+		i += n
+	}
+
+	if i < len(args) {
+		return errorz.Errorf("arg: %s: %w", strings.Join(args[i:], " "), ErrTooManyArguments)
+	}
+
+	c.argValues = values
+	return nil
+}
+
+// getArgAt returns the argument specification for the position, or nil.
+func (c *Command) getArgAt(position int) *Arg {
+	switch {
+	case position < len(c.Args):
+		return c.Args[position]
+	case len(c.Args) > 0 && c.Args[len(c.Args)-1].Variadic:
+		return c.Args[len(c.Args)-1]
+	default:
+		return nil
+	}
+}
+
+// GetArg returns the value of the positional argument specified by Args.
+// If the optional argument is not given, it returns an empty string.
+func (c *Command) GetArg(name string) (string, error) {
+	v, err := c.GetArgs(name)
+	if err != nil {
+		return "", err
+	}
+	if len(v) == 0 {
+		return "", nil
+	}
+
+	return v[0], nil
+}
+
+// GetArgs returns the values of the variadic positional argument specified by Args.
+func (c *Command) GetArgs(name string) ([]string, error) {
+	v, err := c.getArgs(name)
+	if err != nil {
+		return nil, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+func (c *Command) getArgs(name string) ([]string, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	executed := c.GetExecutedCommand()
+	if v, ok := executed.argValues[name]; ok {
+		return v, nil
+	}
+
+	return nil, errorz.Errorf("arg = %s: %w", name, ErrUnknownArgument)
+}
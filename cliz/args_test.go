@@ -0,0 +1,248 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestArgsCommand() *Command {
+	return &Command{
+		Name: "main-cli",
+		SubCommands: []*Command{
+			{
+				Name: "copy",
+				Args: []*Arg{
+					{Name: "src", Description: "source file", Required: true},
+					{Name: "count", Type: ArgTypeInt64},
+					{Name: "dst", Variadic: true, CompleteFunc: func(_ *Command, toComplete string) []string { return []string{toComplete + "dst"} }},
+				},
+			},
+			{
+				Name: "noargs",
+				Args: []*Arg{},
+			},
+			{
+				Name: "unchecked",
+			},
+		},
+	}
+}
+
+func TestCommand_loadArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			osArgs []string
+			src    string
+			count  string
+			dst    []string
+		}{
+			{name: "required_only", osArgs: []string{"main-cli", "copy", "a.txt"}, src: "a.txt", count: "", dst: []string{}},
+			{name: "optional", osArgs: []string{"main-cli", "copy", "a.txt", "3"}, src: "a.txt", count: "3", dst: []string{}},
+			{name: "variadic", osArgs: []string{"main-cli", "copy", "a.txt", "3", "b", "c"}, src: "a.txt", count: "3", dst: []string{"b", "c"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestArgsCommand()
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+				assertz.Equal(t, tt.src, discard(c.GetArg("src")))
+				assertz.Equal(t, tt.count, discard(c.GetArg("count")))
+				assertz.Equal(t, tt.dst, discard(c.GetArgs("dst")))
+			})
+		}
+	})
+
+	t.Run("success,unchecked", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestArgsCommand()
+		args, err := c.parse(context.Background(), []string{"main-cli", "unchecked", "a", "b"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, []string{"a", "b"}, args)
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			osArgs []string
+			err    error
+			errMsg string
+		}{
+			{name: "ErrMissingArgument", osArgs: []string{"main-cli", "copy"}, err: ErrMissingArgument, errMsg: "main-cli copy: arg: <src>: missing argument"},
+			{name: "ErrInvalidArgument", osArgs: []string{"main-cli", "copy", "a.txt", "FAILURE"}, err: ErrInvalidArgument, errMsg: `arg: [<count>]: int64: strconv.ParseInt: parsing "FAILURE": invalid syntax: invalid argument`},
+			{name: "ErrTooManyArguments", osArgs: []string{"main-cli", "noargs", "a", "b"}, err: ErrTooManyArguments, errMsg: "main-cli noargs: arg: a b: too many arguments"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestArgsCommand()
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.ErrorIs(t, err, tt.err)
+				assertz.ErrorContains(t, err, tt.errMsg)
+			})
+		}
+	})
+
+	t.Run("error,ErrUnknownArgument", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestArgsCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "copy", "a.txt"})
+		requirez.NoError(t, err)
+		_, err = c.GetArg("unknown")
+		requirez.ErrorIs(t, err, ErrUnknownArgument)
+	})
+
+	t.Run("error,ErrNotCalled", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestArgsCommand()
+		_, err := c.GetArgs("src")
+		requirez.ErrorIs(t, err, ErrNotCalled)
+	})
+}
+
+func TestCommand_preCheckArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			args []*Arg
+		}{
+			{name: "empty_name", args: []*Arg{{Name: ""}}},
+			{name: "duplicate_name", args: []*Arg{{Name: "a"}, {Name: "a"}}},
+			{name: "variadic_not_last", args: []*Arg{{Name: "a", Variadic: true}, {Name: "b"}}},
+			{name: "required_after_optional", args: []*Arg{{Name: "a"}, {Name: "b", Required: true}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := &Command{Name: "main-cli", Args: tt.args}
+				_, err := c.parse(context.Background(), []string{"main-cli"})
+				requirez.ErrorIs(t, err, ErrInvalidArgSpec)
+			})
+		}
+	})
+}
+
+func TestArgType_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.Equal(t, "duration", ArgTypeDuration.String())
+		assertz.Equal(t, "ArgType(-1)", ArgType(-1).String())
+	})
+}
+
+func TestCommand_DefaultUsage_Args(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestArgsCommand()
+		buf := new(bytes.Buffer)
+		c.SetStderrRecursive(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "copy", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Usage:
+    main-cli copy [options] <src> [<count>] [<dst>...]
+
+Options:
+    --help (default: false)
+        show help message and exit
+
+Arguments:
+    <src> (required, type: string)
+        source file
+    [<count>] (type: int64)
+        int64 value of count
+    [<dst>...] (variadic, type: string)
+        string value of dst
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+}
+
+func TestCommand_completeArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestArgsCommand()
+		c.initAppendHelpOption()
+		copyCmd := c.SubCommands[0]
+		assertz.Equal(t, []string(nil), completeArgs(copyCmd, []string{}, ""))
+		assertz.Equal(t, []string{"xdst"}, completeArgs(copyCmd, []string{"a", "1"}, "x"))
+		assertz.Equal(t, []string{"ydst"}, completeArgs(copyCmd, []string{"a", "1", "b"}, "y"))
+		assertz.Equal(t, []string{"true", "false"}, completeArgs(&Command{Name: "bool", Args: []*Arg{{Name: "b", Type: ArgTypeBool}}}, []string{}, ""))
+		assertz.Equal(t, []string(nil), completeArgs(c.SubCommands[1], []string{}, ""))
+	})
+}
+
+func TestUnmarshalOptions_Args(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		type args struct {
+			Src     string        `cliarg:"src"`
+			Count   int32         `cliarg:"count"`
+			Timeout time.Duration `cliarg:"timeout"`
+			Dst     []string      `cliarg:"dst"`
+		}
+		c := &Command{
+			Name: "main-cli",
+			Args: []*Arg{
+				{Name: "src", Required: true},
+				{Name: "count", Type: ArgTypeInt64},
+				{Name: "timeout", Type: ArgTypeDuration},
+				{Name: "dst", Variadic: true},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "a.txt", "3", "1m", "b", "c"})
+		requirez.NoError(t, err)
+		var v args
+		requirez.NoError(t, UnmarshalOptions(c, &v))
+		assertz.Equal(t, args{Src: "a.txt", Count: 3, Timeout: time.Minute, Dst: []string{"b", "c"}}, v)
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		type args struct {
+			Count int8 `cliarg:"count"`
+		}
+		c := &Command{Name: "main-cli", Args: []*Arg{{Name: "count", Type: ArgTypeInt64}}}
+		_, err := c.parse(context.Background(), []string{"main-cli", "1000"})
+		requirez.NoError(t, err)
+		var v args
+		requirez.ErrorContains(t, UnmarshalOptions(c, &v), `field=Count: tag=cliarg: strconv.ParseInt: parsing "1000": value out of range`)
+	})
+}
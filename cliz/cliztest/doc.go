@@ -0,0 +1,2 @@
+// cliztest package provides the test helpers for the command trees built with cliz, like the runner with the captured output, the golden file assertions for the help message and the validator of the tree.
+package cliztest
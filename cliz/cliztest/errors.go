@@ -0,0 +1,11 @@
+package cliztest
+
+import (
+	"errors"
+)
+
+var (
+	ErrEmptyDescription   = errors.New("empty description")
+	ErrDuplicateAlias     = errors.New("duplicate alias")
+	ErrUnreachableCommand = errors.New("unreachable command")
+)
@@ -0,0 +1,65 @@
+package cliztest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hakadoriya/z.go/cliz"
+	"github.com/hakadoriya/z.go/diffz/simplediffz"
+	"github.com/hakadoriya/z.go/testingz"
+)
+
+// UpdateGoldenEnv is the environment variable to update the golden files instead of comparing with them, like `CLIZTEST_UPDATE_GOLDEN=true go test ./...`.
+const UpdateGoldenEnv = "CLIZTEST_UPDATE_GOLDEN"
+
+// AssertGolden asserts that actual equals the content of the golden file goldenPath.
+//
+// If the environment variable UpdateGoldenEnv is set, it writes actual to goldenPath instead.
+func AssertGolden(tb testing.TB, goldenPath, actual string) (success bool) {
+	tb.Helper()
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0o755); err != nil { //nolint:gosec,mnd
+			tb.Errorf(testingz.DefaultFailMarker+"%s: os.MkdirAll: %v", tb.Name(), err)
+			return false
+		}
+		if err := os.WriteFile(goldenPath, []byte(actual), 0o644); err != nil { //nolint:gosec,mnd
+			tb.Errorf(testingz.DefaultFailMarker+"%s: os.WriteFile: %v", tb.Name(), err)
+			return false
+		}
+		tb.Logf("%s: updated golden file: %s", tb.Name(), goldenPath)
+		return true
+	}
+
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		tb.Errorf(testingz.DefaultFailMarker+"%s: os.ReadFile: %v (set %s=true to create it)", tb.Name(), err, UpdateGoldenEnv)
+		return false
+	}
+
+	if string(expected) != actual {
+		tb.Errorf(testingz.DefaultFailMarker+"%s: golden file %s != actual (set %s=true to update it):\n--- EXPECTED\n+++ ACTUAL\n%s",
+			tb.Name(), goldenPath, UpdateGoldenEnv, simplediffz.Diff(string(expected), actual).String())
+		return false
+	}
+
+	return true
+}
+
+// AssertHelp asserts that the help message of the command specified by osArgs equals the content of the golden file goldenPath.
+//
+// The help option is appended to osArgs, so osArgs is like `[]string{"main-cli", "sub-cmd"}`.
+func AssertHelp(tb testing.TB, c *cliz.Command, osArgs []string, goldenPath string) (success bool) {
+	tb.Helper()
+
+	result := Run(context.Background(), c, append(append([]string{}, osArgs...), "--"+cliz.HelpOptionName))
+	if !errors.Is(result.Err, cliz.ErrHelp) {
+		tb.Errorf(testingz.DefaultFailMarker+"%s: %v: expected help, but got: %v", tb.Name(), osArgs, result.Err)
+		return false
+	}
+
+	return AssertGolden(tb, goldenPath, result.Stderr)
+}
@@ -0,0 +1,70 @@
+package cliztest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestAssertHelp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.True(t, AssertHelp(t, newTestCommand(), []string{"main-cli"}, filepath.Join("testdata", "help.golden")))
+	})
+
+	t.Run("success,subcommand", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.True(t, AssertHelp(t, newTestCommand(), []string{"main-cli", "greet"}, filepath.Join("testdata", "help_greet.golden")))
+	})
+}
+
+// failTB records the failure instead of failing the test.
+type failTB struct {
+	testing.TB
+	failed bool
+}
+
+func (tb *failTB) Errorf(string, ...interface{}) { tb.failed = true }
+
+func TestAssertGolden(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tb := &failTB{TB: t}
+		assertz.True(t, AssertGolden(tb, filepath.Join("testdata", "help.golden"), mustReadFile(t, filepath.Join("testdata", "help.golden"))))
+		assertz.False(t, tb.failed)
+	})
+
+	t.Run("failure,mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		tb := &failTB{TB: t}
+		assertz.False(t, AssertGolden(tb, filepath.Join("testdata", "help.golden"), "unexpected\n"))
+		assertz.True(t, tb.failed)
+	})
+
+	t.Run("failure,not_exist", func(t *testing.T) {
+		t.Parallel()
+
+		tb := &failTB{TB: t}
+		assertz.False(t, AssertGolden(tb, filepath.Join("testdata", "not_exist.golden"), ""))
+		assertz.True(t, tb.failed)
+	})
+}
+
+func mustReadFile(tb testing.TB, path string) string {
+	tb.Helper()
+
+	b, err := os.ReadFile(path)
+	requirez.NoError(tb, err)
+	return string(b)
+}
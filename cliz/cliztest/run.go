@@ -0,0 +1,120 @@
+package cliztest
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/hakadoriya/z.go/cliz"
+)
+
+type (
+	// Result is the result of Run.
+	Result struct {
+		// Stdout is the captured standard output.
+		Stdout string
+		// Stderr is the captured standard error, like the help message and the warnings.
+		Stderr string
+		// Err is the error returned by (*cliz.Command).Exec.
+		Err error
+		// ExitCode is the exit code for Err, which is the same as cliz.Main exits with.
+		ExitCode int
+	}
+
+	runConfig struct {
+		env   map[string]string
+		stdin io.Reader
+	}
+
+	RunOption interface {
+		apply(c *runConfig)
+	}
+)
+
+type withRunOptionEnv struct {
+	key   string
+	value string
+}
+
+func (w *withRunOptionEnv) apply(c *runConfig) {
+	c.env[w.key] = w.value
+}
+
+// WithRunOptionEnv overrides the environment variable seen by the command, without changing the environment of the process.
+// The other environment variables are looked up by cliz.Getenv.
+func WithRunOptionEnv(key, value string) RunOption {
+	return &withRunOptionEnv{key: key, value: value}
+}
+
+type withRunOptionStdin struct {
+	stdin io.Reader
+}
+
+func (w *withRunOptionStdin) apply(c *runConfig) {
+	c.stdin = w.stdin
+}
+
+// WithRunOptionStdin sets the standard input of the command.
+// If Prompter of the root command is nil, the prompts of the command also read the answers from stdin.
+//
+// Without this option, the standard input is empty and the command does not prompt, like the run in CI.
+func WithRunOptionStdin(stdin io.Reader) RunOption {
+	return &withRunOptionStdin{stdin: stdin}
+}
+
+// Run executes the command tree c with osArgs, and returns the captured output and the error.
+//
+// c is modified by the execution, so build the new command tree for each Run.
+//
+//	result := cliztest.Run(ctx, newCommand(), []string{"main-cli", "sub-cmd", "--foo=bar"},
+//		cliztest.WithRunOptionEnv("FOO", "bar"),
+//		cliztest.WithRunOptionStdin(strings.NewReader("yes\n")),
+//	)
+func Run(ctx context.Context, c *cliz.Command, osArgs []string, opts ...RunOption) *Result {
+	cfg := &runConfig{
+		env:   make(map[string]string),
+		stdin: nil,
+	}
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+
+	stdout := bytes.NewBuffer(nil)
+	stderr := bytes.NewBuffer(nil)
+	if cfg.stdin != nil {
+		c.SetStdinRecursive(cfg.stdin)
+		if c.Prompter == nil {
+			c.Prompter = cliz.NewReaderPrompter(cfg.stdin, stderr)
+		}
+	} else {
+		// NOTE: Do not read the stdin of the test process, and fail as the non-interactive run instead of prompting.
+		c.SetStdinRecursive(bytes.NewReader(nil))
+		if c.Prompter == nil {
+			c.Prompter = nonInteractivePrompter{}
+		}
+	}
+	c.SetStdoutRecursive(stdout)
+	c.SetStderrRecursive(stderr)
+	c.SetGetenvRecursive(func(key string) string {
+		if value, ok := cfg.env[key]; ok {
+			return value
+		}
+		return cliz.Getenv(key)
+	})
+
+	err := c.Exec(ctx, osArgs)
+
+	return &Result{
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		Err:      err,
+		ExitCode: cliz.ExitCode(err),
+	}
+}
+
+// nonInteractivePrompter is the Prompter which never prompts.
+type nonInteractivePrompter struct{}
+
+func (nonInteractivePrompter) IsInteractive() bool { return false }
+
+func (nonInteractivePrompter) Prompt(string, bool) (string, error) { return "", io.EOF }
@@ -0,0 +1,80 @@
+package cliztest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/cliz"
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestCommand() *cliz.Command {
+	return &cliz.Command{
+		Name:        "main-cli",
+		Description: "main-cli is a test command",
+		Interactive: true,
+		Options: []cliz.Option{
+			&cliz.StringOption{Name: "name", Aliases: []string{"n"}, Env: "CLIZTEST_NAME", Required: true, Description: "name to greet"},
+		},
+		SubCommands: []*cliz.Command{
+			{
+				Name:        "greet",
+				Description: "print the greeting",
+				ExecFunc: func(c *cliz.Command, _ []string) error {
+					name, err := c.GetOptionString("name")
+					if err != nil {
+						return err //nolint:wrapcheck
+					}
+					_, _ = io.WriteString(c.Stdout(), "hello, "+name+"\n")
+					return nil
+				},
+			},
+			{
+				Name:        "cat",
+				Description: "copy stdin to stdout",
+				ExecFunc: func(c *cliz.Command, _ []string) error {
+					_, err := io.Copy(c.Stdout(), c.Stdin())
+					return err //nolint:wrapcheck
+				},
+			},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		osArgs   []string
+		opts     []RunOption
+		stdout   string
+		stderr   string
+		exitCode int
+	}{
+		{name: "success,arg", osArgs: []string{"main-cli", "-n", "alice", "greet"}, opts: nil, stdout: "hello, alice\n", stderr: "", exitCode: cliz.ExitCodeOK},
+		{name: "success,env", osArgs: []string{"main-cli", "greet"}, opts: []RunOption{WithRunOptionEnv("CLIZTEST_NAME", "bob")}, stdout: "hello, bob\n", stderr: "", exitCode: cliz.ExitCodeOK},
+		{name: "success,prompt", osArgs: []string{"main-cli", "greet"}, opts: []RunOption{WithRunOptionStdin(strings.NewReader("carol\n"))}, stdout: "hello, carol\n", stderr: "--name (name to greet): ", exitCode: cliz.ExitCodeOK},
+		{name: "success,stdin", osArgs: []string{"main-cli", "--name=dave", "cat"}, opts: []RunOption{WithRunOptionStdin(strings.NewReader("input"))}, stdout: "input", stderr: "", exitCode: cliz.ExitCodeOK},
+		{name: "error,ErrOptionRequired", osArgs: []string{"main-cli", "greet"}, opts: nil, stdout: "", stderr: "", exitCode: cliz.ExitCodeUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := Run(context.Background(), newTestCommand(), tt.osArgs, tt.opts...)
+			if tt.exitCode == cliz.ExitCodeOK {
+				requirez.NoError(t, result.Err)
+			} else {
+				requirez.ErrorIs(t, result.Err, cliz.ErrOptionRequired)
+			}
+			assertz.Equal(t, tt.stdout, result.Stdout)
+			assertz.Equal(t, tt.stderr, result.Stderr)
+			assertz.Equal(t, tt.exitCode, result.ExitCode)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package cliztest
+
+import (
+	"errors"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/cliz"
+	"github.com/hakadoriya/z.go/errorz"
+	"github.com/hakadoriya/z.go/testingz"
+)
+
+// Validate checks the whole command tree c, and returns all the problems joined by errors.Join, or nil.
+//
+// The problems are the following, and the hidden commands and options are not checked for the description:
+//
+//   - ErrEmptyDescription: the command or the option has no description.
+//   - ErrDuplicateAlias: the name or the alias is used by the sibling commands, or by the options of the command and its ancestors.
+//   - ErrUnreachableCommand: the command cannot be specified by any name, because it has no name, the name starts with "-", or all the names are used by the preceding siblings.
+func Validate(c *cliz.Command) error {
+	v := &validator{errs: make([]error, 0)}
+	v.validateCommand(c, []string{c.Name}, make(map[string]string))
+	return errors.Join(v.errs...)
+}
+
+// AssertValid asserts that Validate returns no problems for the command tree c.
+func AssertValid(tb testing.TB, c *cliz.Command) (success bool) {
+	tb.Helper()
+
+	if err := Validate(c); err != nil {
+		tb.Errorf(testingz.DefaultFailMarker+"%s: invalid command tree:\n%v", tb.Name(), err)
+		return false
+	}
+	return true
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) addf(format string, args ...interface{}) {
+	v.errs = append(v.errs, errorz.Errorf(format, args...))
+}
+
+// validateCommand validates c and its descendants.
+// optionNames is the option names and aliases of the ancestors, which are mapped to the owners.
+func (v *validator) validateCommand(c *cliz.Command, path []string, optionNames map[string]string) {
+	cmdPath := strings.Join(path, " ")
+
+	if !c.Hidden && c.Description == "" {
+		v.addf("%s: %w", cmdPath, ErrEmptyDescription)
+	}
+
+	// NOTE: Ancestor command options and descendant command options must not be duplicated, as cliz checks before parsing.
+	for _, opt := range slices.Concat(c.Options, c.PersistentOptions) {
+		v.validateOption(cmdPath, opt, optionNames)
+	}
+
+	v.validateSubCommands(c, path)
+
+	for _, subcmd := range c.SubCommands {
+		// NOTE: The sibling subcommands' options can be duplicated, because they are not called at the same time.
+		v.validateCommand(subcmd, append(slices.Clone(path), subcmd.Name), maps.Clone(optionNames))
+	}
+}
+
+func (v *validator) validateOption(cmdPath string, opt cliz.Option, optionNames map[string]string) {
+	optName := "--" + opt.GetName()
+
+	if _, ok := opt.(*cliz.HelpOption); ok {
+		// NOTE: HelpOption is added to all the commands and has the default description.
+		return
+	}
+
+	if !opt.IsHidden() && optionDescription(opt) == "" {
+		v.addf("%s: option %s: %w", cmdPath, optName, ErrEmptyDescription)
+	}
+
+	names := slices.Concat([]string{opt.GetName()}, opt.GetAliases(), slices.Sorted(maps.Keys(opt.GetDeprecatedAliases())))
+	for _, name := range slices.Compact(names) {
+		if name == "" {
+			continue
+		}
+		if owner, ok := optionNames[name]; ok {
+			v.addf("%s: option %s: %s is also used by %s: %w", cmdPath, optName, name, owner, ErrDuplicateAlias)
+			continue
+		}
+		optionNames[name] = cmdPath + " " + optName
+	}
+}
+
+func (v *validator) validateSubCommands(c *cliz.Command, path []string) {
+	cmdPath := strings.Join(path, " ")
+
+	// commandNames is the names and the aliases of the preceding siblings.
+	commandNames := make(map[string]string)
+	for i, subcmd := range c.SubCommands {
+		names := slices.Concat([]string{subcmd.Name}, subcmd.Aliases, slices.Sorted(maps.Keys(subcmd.DeprecatedAliases)))
+		subcmdLabel := subcmd.Name
+		if subcmdLabel == "" {
+			subcmdLabel = "#" + strconv.Itoa(i)
+		}
+
+		reachable := false
+		for _, name := range slices.Compact(names) {
+			if name == "" || strings.HasPrefix(name, "-") {
+				continue
+			}
+			if owner, ok := commandNames[name]; ok {
+				v.addf("%s: command %s: %s is also used by %s: %w", cmdPath, subcmdLabel, name, owner, ErrDuplicateAlias)
+				continue
+			}
+			commandNames[name] = subcmdLabel
+			reachable = true
+		}
+
+		if !reachable {
+			v.addf("%s: command %s: %w", cmdPath, subcmdLabel, ErrUnreachableCommand)
+		}
+	}
+}
+
+// optionDescription returns the Description field of the option, because GetDescription returns the default description.
+func optionDescription(opt cliz.Option) string {
+	rv := reflect.Indirect(reflect.ValueOf(opt))
+	if rv.Kind() != reflect.Struct {
+		return opt.GetDescription()
+	}
+	field := rv.FieldByName("Description")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return opt.GetDescription()
+	}
+	return field.String()
+}
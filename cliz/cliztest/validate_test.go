@@ -0,0 +1,81 @@
+package cliztest
+
+import (
+	"testing"
+
+	"github.com/hakadoriya/z.go/cliz"
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		requirez.NoError(t, Validate(newTestCommand()))
+		assertz.True(t, AssertValid(t, newTestCommand()))
+	})
+
+	t.Run("success,hidden", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.SubCommands = append(c.SubCommands, &cliz.Command{
+			Name:    "debug",
+			Hidden:  true,
+			Options: []cliz.Option{&cliz.BoolOption{Name: "trace", Hidden: true}},
+		})
+		requirez.NoError(t, Validate(c))
+	})
+
+	t.Run("error,ErrEmptyDescription", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.SubCommands[0].Description = ""
+		c.SubCommands[1].Options = []cliz.Option{&cliz.BoolOption{Name: "verbose"}}
+
+		err := Validate(c)
+		requirez.ErrorIs(t, err, ErrEmptyDescription)
+		assertz.Equal(t, "main-cli greet: empty description\nmain-cli cat: option --verbose: empty description", err.Error())
+	})
+
+	t.Run("error,ErrDuplicateAlias", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.SubCommands[0].Aliases = []string{"g", "cat"}
+		c.SubCommands[1].Options = []cliz.Option{&cliz.StringOption{Name: "number", Aliases: []string{"n"}, Description: "number of lines"}}
+
+		err := Validate(c)
+		requirez.ErrorIs(t, err, ErrDuplicateAlias)
+		assertz.Equal(t, "main-cli: command cat: cat is also used by greet: duplicate alias\nmain-cli: command cat: unreachable command\nmain-cli cat: option --number: n is also used by main-cli --name: duplicate alias", err.Error())
+	})
+
+	t.Run("error,ErrUnreachableCommand", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.SubCommands = append(c.SubCommands,
+			&cliz.Command{Name: "", Description: "no name"},
+			&cliz.Command{Name: "--flag", Description: "looks like the option"},
+		)
+
+		err := Validate(c)
+		requirez.ErrorIs(t, err, ErrUnreachableCommand)
+		assertz.Equal(t, "main-cli: command #2: unreachable command\nmain-cli: command --flag: unreachable command", err.Error())
+	})
+
+	t.Run("failure,AssertValid", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.Description = ""
+
+		tb := &failTB{TB: t}
+		assertz.False(t, AssertValid(tb, c))
+		assertz.True(t, tb.failed)
+	})
+}
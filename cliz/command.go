@@ -26,25 +26,88 @@ type (
 		UsageFunc func(c *Command)
 		// Description is the description of the command.
 		Description string
+		// Examples is the examples of the command shown in the help message.
+		Examples []*Example
+		// HelpTemplate is the text/template of the help message. If HelpTemplate is empty, the nearest ancestor's or DefaultHelpTemplate is used.
+		//
+		// The data of the template is *HelpData. See DefaultHelpTemplate for the available functions.
+		HelpTemplate string
 		// Options is the options of the command.
 		Options []Option
-		// PreHookExecFunc is the function to be executed before ExecFunc.
+		// PersistentOptions is the options of the command which are also accepted after any descendant subcommand, like `main-cli sub-cmd --verbose`.
+		//
+		// The getters like (*Command).GetOptionString resolve them in the same way as Options.
+		PersistentOptions []Option
+		// Args is the specification of the positional arguments of the command.
+		//
+		// If Args is nil, the positional arguments are not checked. If Args is empty but not nil, no positional arguments are accepted.
+		Args []*Arg
+		// ConstraintGroups is the constraints among the options of the command, like mutually exclusive options.
+		ConstraintGroups []*ConstraintGroup
+		// PreHookExecFunc is the function to be executed before ExecFunc. args is the same as ExecFunc.
 		PreHookExecFunc func(c *Command, args []string) error
 		// ExecFunc is the function to be executed when (*Command).Exec is executed.
+		//
+		// args is the positional arguments of the command, which does not include the program name osArgs[0] even for the root command.
 		ExecFunc func(c *Command, args []string) error
-		// PostHookExecFunc is the function to be executed after ExecFunc.
+		// PostHookExecFunc is the function to be executed after ExecFunc. args is the same as ExecFunc.
 		PostHookExecFunc func(c *Command, args []string) error
 		// SubCommands is the subcommands of the command.
 		SubCommands []*Command
 		// If Hidden is true, the command is not displayed in the help message and completion.
 		Hidden bool
+		// Deprecated is the deprecation of the command. The command still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the command, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the positional arguments for shell completion.
+		//
+		// args is the positional arguments already typed for the command, and toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, args []string, toComplete string) (candidates []string)
+		// POSIXStyle enables the POSIX/GNU style option parsing. Set it to the root command, then it applies to all the subcommands.
+		//
+		// In addition to the default style, the following forms are accepted:
+		//
+		//	-vxf     combined boolean short options, equivalent to `-v -x -f`
+		//	-n5      attached short option value, equivalent to `-n 5`
+		//	-vofile  combination of the above, equivalent to `-v -o file`
+		//	--no-foo negation of the boolean option, equivalent to `--foo=false`
+		POSIXStyle bool
+		// ConfigFile is the config file source of the option values. Set it to the root command.
+		//
+		// If ConfigFile is not nil, the option to specify the config file path is added to the root command,
+		// and the option values are loaded with the precedence: command line arguments > environment variables > config file > default.
+		ConfigFile *ConfigFile
+		// Output is the output format option used by (*Command).Render. Set it to the root command.
+		//
+		// If Output is not nil, the option to specify the output format is added to PersistentOptions of the command.
+		Output *Output
+		// Interactive enables the prompts for the missing required options and Confirm. Set it to the root command.
+		//
+		// The prompts are shown only if Prompter is interactive, so the non-TTY and CI runs fail fast as without Interactive.
+		Interactive bool
+		// Prompter is the Prompter for Interactive. If Prompter is nil, DefaultPrompter is used.
+		Prompter Prompter
+		// Confirm is the confirmation message shown before the command is executed, for the destructive command.
+		//
+		// If Confirm is not empty, the `--yes` option to skip the confirmation is added to the command,
+		// and the command fails with ErrNotConfirmed unless the user answers yes.
+		Confirm string
+		// Plugins is the plugin discovery of the external executables, like `git foo` runs `git-foo`. Set it to the root command.
+		Plugins *Plugins
 
+		// stdin is the standard input.
+		// If use in ExecFunc, get io.Reader from (command).Stdin().
+		stdin io.Reader
 		// stdout is the standard output.
 		// If use in ExecFunc, get io.Writer from (command).GetStdout().
 		stdout io.Writer
 		// stderr is the standard output.
 		// If use in ExecFunc, get io.Writer from (command).GetStderr().
 		stderr io.Writer
+		// getenv looks up the environment variable.
+		// If use in ExecFunc, call (command).Getenv().
+		getenv func(key string) string
 
 		// ctx is the context.
 		// MEMO: If the structure does not include context.Context, and you want to use the value
@@ -62,6 +125,24 @@ type (
 		ctx                     context.Context
 		allExecutedCommandNames []string
 		remainingArgs           []string
+		// parsed is true after (*Command).Parse succeeds, so that Exec does not parse again.
+		parsed bool
+		// posixStyle is POSIXStyle inherited from the parent command.
+		posixStyle bool
+		// parent is the parent command, or nil for the root command.
+		parent *Command
+		// plugin is the plugin specified by the command line arguments.
+		plugin *plugin
+		// completionGenerator is true for the hidden subcommands which the completion scripts call back.
+		completionGenerator bool
+		// optionSources is the sources of the option values.
+		optionSources map[Option]OptionSource
+		// configFilePath is the path of the loaded config file.
+		configFilePath string
+		// argValues is the values of the positional arguments keyed by the name of Args.
+		argValues map[string][]string
+		// deprecationWarned is the deprecated names already warned, which is used in the root command.
+		deprecationWarned map[string]bool
 	}
 )
 
@@ -103,6 +184,9 @@ func (c *Command) is(cmdName string) bool {
 			return true
 		}
 	}
+	if _, ok := c.DeprecatedAliases[cmdName]; ok {
+		return true
+	}
 	return false
 }
 
@@ -2,6 +2,7 @@ package cliz
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/hakadoriya/z.go/errorz"
@@ -10,9 +11,23 @@ import (
 func (c *Command) Exec(ctx context.Context, osArgs []string) (err error) {
 	remainingArgs, err := c.parse(ctx, osArgs)
 	if err != nil {
+		var suggestionErr *SuggestionError
+		if errors.As(err, &suggestionErr) {
+			if executed := c.GetExecutedCommand(); executed != nil {
+				executed.printSuggestion(suggestionErr)
+				executed.usage()
+			}
+		}
 		return errorz.Errorf("cmd = %s: parse: %w", strings.Join(c.allExecutedCommandNames, " "), err)
 	}
 
+	if c.plugin != nil {
+		if err := c.execPlugin(ctx, remainingArgs); err != nil {
+			return errorz.Errorf("cmd = %s: plugin: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+		}
+		return nil
+	}
+
 	executed := c.GetExecutedCommand()
 
 	defer func() {
@@ -2,7 +2,7 @@ package cliz
 
 import (
 	"context"
-	"strconv"
+	"slices"
 	"strings"
 
 	"github.com/hakadoriya/z.go/contextz"
@@ -10,26 +10,35 @@ import (
 )
 
 // NOTE: Use (*cliz.Command).Parse when the cliz package user wants to test the behavior of command line options. Usually, (*cliz.Command).Exec is sufficient.
+//
+// osArgs is like os.Args, and osArgs[0] is the program name.
+// remainingArgs is the positional arguments of the executed command, which does not include osArgs[0] even for the root command.
 func (c *Command) Parse(ctx context.Context, osArgs []string) (remainingArgs []string, err error) {
 	return c.parse(ctx, osArgs)
 }
 
 func (c *Command) parse(ctx context.Context, osArgs []string) (remainingArgs []string, err error) {
-	if len(c.remainingArgs) > 0 {
-		// If remainingArgs is not empty, it is already parsed.
+	if c.parsed {
+		// NOTE: The init functions below are not idempotent, so do not parse again.
 		return c.remainingArgs, nil
 	}
 
 	c.ctx = ctx
 	defer func() {
 		c.ctx = WithContext(c.ctx, c)
+		c.parsed = err == nil
 	}()
 
 	// following is not idempotent.
 
 	c.initAppendHelpOption()
+	c.initAppendOutputOption()
+	c.initAppendSensitiveFileOptions()
+	c.initAppendPersistentOptions()
+	c.initAppendConfigFileOption()
+	c.initAppendConfirmOption()
 	c.initAppendCompletionSubCommand()
-	c.initAppendGenerateBashCompletionSubCommands()
+	c.initAppendGenerateCompletionSubCommands()
 
 	if err := c.preCheckSubCommands(); err != nil {
 		return nil, errorz.Errorf("failed to pre-check commands: %w", err)
@@ -39,14 +48,27 @@ func (c *Command) parse(ctx context.Context, osArgs []string) (remainingArgs []s
 		return nil, errorz.Errorf("failed to pre-check options: %w", err)
 	}
 
+	if err := c.preCheckArgs(); err != nil {
+		return nil, errorz.Errorf("failed to pre-check arguments: %w", err)
+	}
+
 	if err := c.loadDefaults(); err != nil {
 		return nil, errorz.Errorf("failed to load default: %w", err)
 	}
 
+	if err := c.loadConfigFile(osArgs); err != nil {
+		return nil, errorz.Errorf("failed to load config file: %w", err)
+	}
+
 	if err := c.loadEnvironments(); err != nil {
 		return nil, errorz.Errorf("failed to load environment: %w", err)
 	}
 
+	// NOTE: osArgs[0] is the program name.
+	if len(osArgs) > 0 {
+		osArgs = osArgs[1:]
+	}
+
 	remaining, err := c.parseArgs(osArgs)
 	if err != nil {
 		return nil, errorz.Errorf("failed to parse arguments: %w", err)
@@ -56,10 +78,32 @@ func (c *Command) parse(ctx context.Context, osArgs []string) (remainingArgs []s
 		return nil, err
 	}
 
+	// NOTE: The plugin checks its own options and arguments.
+	if c.plugin != nil {
+		return remaining, nil
+	}
+
+	// NOTE: The completion generators complete the partial command line, so the required options, the confirmation and the prompts do not apply.
+	if executed := c.GetExecutedCommand(); executed != nil && executed.completionGenerator {
+		return remaining, nil
+	}
+
+	if err := c.loadSensitiveFiles(); err != nil {
+		return nil, errorz.Errorf("failed to load sensitive option files: %w", err)
+	}
+
 	if err := c.postCheckOptions(); err != nil {
 		return nil, errorz.Errorf("failed to post-check options: %w", err)
 	}
 
+	if err := c.postCheckArgs(remaining); err != nil {
+		return nil, errorz.Errorf("failed to check arguments: %w", err)
+	}
+
+	if err := c.checkConfirm(); err != nil {
+		return nil, errorz.Errorf("failed to confirm: %w", err)
+	}
+
 	if err := contextz.CheckContext(ctx); err != nil {
 		return nil, errorz.Errorf("failed to check context: %w", err)
 	}
@@ -69,10 +113,15 @@ func (c *Command) parse(ctx context.Context, osArgs []string) (remainingArgs []s
 
 func ptr[T interface{}](v T) *T { return &v }
 
-//nolint:cyclop,funlen,gocognit,gocyclo
 func (c *Command) parseArgs(osArgs []string) (remainingArgs []string, err error) {
+	// parsedOptions is the options that have been set from the command line arguments.
+	// It is shared with the subcommands, because PersistentOptions can be specified before and after the subcommand.
+	return c.parseArgsWithParsedOptions(osArgs, make(map[optionValue]bool))
+}
+
+//nolint:cyclop
+func (c *Command) parseArgsWithParsedOptions(osArgs []string, parsedOptions map[optionValue]bool) (remainingArgs []string, err error) {
 	defer func() { c.remainingArgs = remainingArgs }()
-	const base, bitSize = 10, 64
 
 	c.allExecutedCommandNames = append(c.allExecutedCommandNames, c.Name)
 	remainingArgs = make([]string, 0)
@@ -86,132 +135,47 @@ argsLoop:
 			remainingArgs = append(remainingArgs, osArgs[i+1:]...)
 			break argsLoop
 		case strings.HasPrefix(osArg, shortOptionPrefix):
-			for _, opt := range c.Options {
-				switch o := opt.(type) {
-				case *StringOption:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						if hasNoOptionValue(osArgs, i) {
-							return nil, errorz.Errorf("%s: %w", osArg, ErrMissingOptionValue)
-						}
-						o.value = ptr(osArgs[i+1])
-						i++
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						o.value = ptr(extractValueFromHyphenOptionEqual(osArg))
-						continue argsLoop
-					}
-				case *BoolOption:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						o.value = ptr(true)
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						optVal, err := strconv.ParseBool(extractValueFromHyphenOptionEqual(osArg))
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						continue argsLoop
-					}
-				case *Int64Option:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						if hasNoOptionValue(osArgs, i) {
-							return nil, errorz.Errorf("%s: %w", osArg, ErrMissingOptionValue)
-						}
-						optVal, err := strconv.ParseInt(osArgs[i+1], base, bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						i++
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						optVal, err := strconv.ParseInt(extractValueFromHyphenOptionEqual(osArg), base, bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						continue argsLoop
-					}
-				case *Uint64Option:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						if hasNoOptionValue(osArgs, i) {
-							return nil, errorz.Errorf("%s: %w", osArg, ErrMissingOptionValue)
-						}
-						optVal, err := strconv.ParseUint(osArgs[i+1], base, bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						i++
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						optVal, err := strconv.ParseUint(extractValueFromHyphenOptionEqual(osArg), base, bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						continue argsLoop
-					}
-				case *Float64Option:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						if hasNoOptionValue(osArgs, i) {
-							return nil, errorz.Errorf("%s: %w", osArg, ErrMissingOptionValue)
-						}
-						optVal, err := strconv.ParseFloat(osArgs[i+1], bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						i++
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						optVal, err := strconv.ParseFloat(extractValueFromHyphenOptionEqual(osArg), bitSize)
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						continue argsLoop
-					}
-				case *HelpOption:
-					switch {
-					case argIsHyphenOption(o, osArg):
-						o.value = ptr(true)
-						continue argsLoop
-					case argIsHyphenOptionEqual(o, osArg):
-						optVal, err := strconv.ParseBool(extractValueFromHyphenOptionEqual(osArg))
-						if err != nil {
-							return nil, errorz.Errorf("%s: %w", osArg, err)
-						}
-						o.value = &optVal
-						continue argsLoop
-					}
-				default:
-					return nil, errorz.Errorf("%s: %w", osArg, ErrInvalidOptionType)
-				}
+			consumed, err := c.parseOptionArg(osArgs, i, parsedOptions)
+			if err != nil {
+				return nil, err
 			}
-			return nil, errorz.Errorf("%s: %w", osArg, ErrUnknownOption)
+			i += consumed
+			continue argsLoop
 		default:
 			if subcmd := c.getSubcommand(osArg); subcmd != nil {
+				c.warnDeprecatedSubcommand(subcmd, osArg)
 				//nolint:fatcontext
 				subcmd.ctx = c.ctx
 				subcmd.allExecutedCommandNames = c.allExecutedCommandNames
+				subcmd.posixStyle = c.isPOSIXStyle()
 				defer func() {
 					// NOTE: Propagate the updated subcmd.ctx to c.ctx in subcmd processing.
 					c.ctx = subcmd.ctx
 					c.allExecutedCommandNames = subcmd.allExecutedCommandNames
 				}()
-				remainingArgs, err = subcmd.parseArgs(osArgs[i+1:])
+				remainingArgs, err = subcmd.parseArgsWithParsedOptions(osArgs[i+1:], parsedOptions)
 				if err != nil {
 					return nil, errorz.Errorf("%s: %s: %w", c.Name, osArg, err)
 				}
 				return remainingArgs, nil
 			}
 
+			// NOTE: The first argument which is not a subcommand may be a plugin, and the rest of the arguments are for the plugin.
+			if c.Plugins != nil && len(remainingArgs) == 0 {
+				if p := c.getPlugin(osArg); p != nil {
+					c.plugin = p
+					remainingArgs = append(remainingArgs, osArgs[i+1:]...)
+					break argsLoop
+				}
+			}
+
+			// NOTE: If the command cannot be executed by itself and the argument is similar to a subcommand, it is a typo of the subcommand.
+			if c.ExecFunc == nil {
+				if err := c.newUnknownCommandError(osArg); len(err.Suggestions) > 0 {
+					return nil, errorz.Errorf("%w", err)
+				}
+			}
+
 			// If sub is nil, it is not a subcommand.
 			remainingArgs = append(remainingArgs, osArg)
 			continue argsLoop
@@ -220,3 +184,119 @@ argsLoop:
 
 	return remainingArgs, nil
 }
+
+func (c *Command) isPOSIXStyle() bool {
+	return c.POSIXStyle || c.posixStyle
+}
+
+// parseOptionArg parses osArgs[i] as the option, and returns the number of the consumed arguments after osArgs[i].
+//
+//nolint:cyclop
+func (c *Command) parseOptionArg(osArgs []string, i int, parsedOptions map[optionValue]bool) (consumed int, err error) {
+	osArg := osArgs[i]
+
+	for _, opt := range c.getAcceptedOptions() {
+		o, ok := opt.(optionValue)
+		if !ok {
+			return 0, errorz.Errorf("%s: %w", osArg, ErrInvalidOptionType)
+		}
+
+		var optVal string
+		switch {
+		case argIsHyphenOption(o, osArg):
+			if o.isBoolFlag() {
+				optVal = "true"
+				break
+			}
+			if hasNoOptionValue(osArgs, i) {
+				return 0, errorz.Errorf("%s: %w", osArg, ErrMissingOptionValue)
+			}
+			optVal = osArgs[i+1]
+			consumed = 1
+		case argIsHyphenOptionEqual(o, osArg):
+			optVal = extractValueFromHyphenOptionEqual(osArg)
+		case c.isPOSIXStyle() && o.isBoolFlag() && argIsNegatedHyphenOption(o, osArg):
+			optVal = "false"
+		default:
+			continue
+		}
+
+		if err := c.setParsedOptionValue(o, optVal, parsedOptions); err != nil {
+			// NOTE: osArg may contain the value, like `--token=xxx`.
+			return 0, maskOptionError(o, optVal, errorz.Errorf("%s: %w", osArg, err))
+		}
+		c.warnDeprecatedOption(o, optionNameOfArg(o, osArg))
+		return consumed, nil
+	}
+
+	if c.isPOSIXStyle() && argIsShortOptionCluster(osArg) {
+		return c.parseShortOptionCluster(osArgs, i, parsedOptions)
+	}
+
+	return 0, errorz.Errorf("%w", c.newUnknownOptionError(osArg))
+}
+
+// parseShortOptionCluster parses osArgs[i] like `-vxf` or `-n5` as the cluster of the single character short options.
+func (c *Command) parseShortOptionCluster(osArgs []string, i int, parsedOptions map[optionValue]bool) (consumed int, err error) {
+	osArg := osArgs[i]
+	cluster := strings.TrimPrefix(osArg, shortOptionPrefix)
+
+	for j, r := range cluster {
+		name := string(r)
+		o := c.getOptionValueByShortAlias(name)
+		if o == nil {
+			return 0, errorz.Errorf("%w", c.newUnknownOptionError(shortOptionPrefix+name))
+		}
+
+		var optVal string
+		rest := cluster[j+len(name):]
+		switch {
+		case o.isBoolFlag():
+			optVal = "true"
+		case rest != "":
+			// NOTE: The rest of the cluster is the value of the option, like `-n5` or `-ofile`.
+			optVal = rest
+		case hasNoOptionValue(osArgs, i):
+			return 0, errorz.Errorf("%s: %s%s: %w", osArg, shortOptionPrefix, name, ErrMissingOptionValue)
+		default:
+			optVal = osArgs[i+1]
+			consumed = 1
+		}
+
+		if err := c.setParsedOptionValue(o, optVal, parsedOptions); err != nil {
+			return 0, maskOptionError(o, optVal, errorz.Errorf("%s: %s%s: %w", osArg, shortOptionPrefix, name, err))
+		}
+		c.warnDeprecatedOption(o, name)
+		if !o.isBoolFlag() {
+			return consumed, nil
+		}
+	}
+
+	return consumed, nil
+}
+
+func (c *Command) getOptionValueByShortAlias(alias string) optionValue {
+	for _, opt := range c.getAcceptedOptions() {
+		o, ok := opt.(optionValue)
+		if !ok {
+			continue
+		}
+		if slices.Contains(getOptionAliases(o), alias) {
+			return o
+		}
+	}
+	return nil
+}
+
+func (c *Command) setParsedOptionValue(o optionValue, optVal string, parsedOptions map[optionValue]bool) error {
+	// NOTE: The first value from the command line arguments discards the value from the default or the environment variable.
+	if !parsedOptions[o] {
+		resetOptionValue(o)
+		parsedOptions[o] = true
+	}
+	if err := o.setValue(optVal); err != nil {
+		return err //nolint:wrapcheck
+	}
+	c.getOptionOwner(o).setOptionSource(o, OptionSourceArg)
+	return nil
+}
@@ -3,7 +3,10 @@ package cliz
 import (
 	"bytes"
 	"context"
+	"net/netip"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hakadoriya/z.go/testingz/assertz"
 	"github.com/hakadoriya/z.go/testingz/requirez"
@@ -16,6 +19,46 @@ func TestCommand_Parse(t *testing.T) {
 		_, err := c.Parse(context.Background(), []string{"main-cli"})
 		requirez.ErrorContains(t, err, `strconv.ParseBool: parsing "FAILURE": invalid syntax`)
 	})
+
+	t.Run("success,program_name_is_not_remaining", func(t *testing.T) {
+		var execArgs []string
+		newCommand := func() *Command {
+			//nolint:exhaustruct
+			return &Command{
+				Name: "main-cli",
+				ExecFunc: func(_ *Command, args []string) error {
+					execArgs = args
+					return nil
+				},
+			}
+		}
+		remaining, err := newCommand().Parse(context.Background(), []string{"main-cli", "x", "y"})
+		requirez.NoError(t, err)
+		requirez.Equal(t, []string{"x", "y"}, remaining)
+
+		requirez.NoError(t, newCommand().Exec(context.Background(), []string{"main-cli", "x", "y"}))
+		requirez.Equal(t, []string{"x", "y"}, execArgs)
+	})
+
+	t.Run("success,Parse_then_Exec", func(t *testing.T) {
+		for _, osArgs := range [][]string{{"main-cli"}, {"main-cli", "x"}, {"main-cli", "sub-cmd"}} {
+			var execArgs []string
+			execFunc := func(_ *Command, args []string) error {
+				execArgs = args
+				return nil
+			}
+			//nolint:exhaustruct
+			c := &Command{
+				Name:        "main-cli",
+				ExecFunc:    execFunc,
+				SubCommands: []*Command{{Name: "sub-cmd", ExecFunc: execFunc}},
+			}
+			remaining, err := c.Parse(context.Background(), osArgs)
+			requirez.NoError(t, err)
+			requirez.NoError(t, c.Exec(context.Background(), osArgs))
+			requirez.Equal(t, remaining, execArgs)
+		}
+	})
 }
 
 func TestCommand_parse(t *testing.T) {
@@ -264,4 +307,210 @@ func TestCommand_parseArgs(t *testing.T) {
 		requirez.ErrorIs(t, err, ErrUnknownOption)
 		assertz.ErrorContains(t, err, "main-cli: sub-cmd: --unknown: unknown option")
 	})
+	t.Run("success,SliceOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&StringSliceOption{Name: "tag", Aliases: []string{"t"}, Default: []string{"default"}},
+				&Int64SliceOption{Name: "id"},
+				&Uint64SliceOption{Name: "port"},
+				&Float64SliceOption{Name: "ratio", Default: []float64{0.5}},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--tag", "a", "-t=b,c", "--tag", `"d,e"`, "--id=-1,2", "--id", "3", "--port", "80,443"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, []string{"a", "b", "c", "d,e"}, discard(c.GetOptionStringSlice("tag")))
+		assertz.Equal(t, []int64{-1, 2, 3}, discard(c.GetOptionInt64Slice("id")))
+		assertz.Equal(t, []uint64{80, 443}, discard(c.GetOptionUint64Slice("port")))
+		assertz.Equal(t, []float64{0.5}, discard(c.GetOptionFloat64Slice("ratio")))
+	})
+
+	t.Run("error,SliceOption,ErrMissingOptionValue", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", Options: []Option{&StringSliceOption{Name: "tag"}}}
+		_, err := c.parseArgs([]string{"--tag"})
+		requirez.ErrorIs(t, err, ErrMissingOptionValue)
+	})
+
+	t.Run("error,SliceOption,strconv.ParseInt", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", Options: []Option{&Int64SliceOption{Name: "id"}}}
+		_, err := c.parseArgs([]string{"--id=1,FAILURE"})
+		requirez.ErrorContains(t, err, `--id=1,FAILURE: strconv.ParseInt: parsing "FAILURE": invalid syntax`)
+	})
+	t.Run("success,TypedOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&DurationOption{Name: "timeout", Default: time.Second},
+				&TimeOption{Name: "since"},
+				&TimeOption{Name: "date", Layout: time.DateOnly},
+				&IPOption{Name: "addr"},
+				&PrefixOption{Name: "cidr"},
+				&URLOption{Name: "endpoint"},
+				&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}, Default: "json"},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--timeout", "1m30s", "--since=2024-01-02T03:04:05Z", "--date", "2024-01-02", "--addr", "2001:db8::1", "--cidr=192.0.2.0/24", "--endpoint", "https://example.com/api", "--format=yaml"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, 90*time.Second, discard(c.GetOptionDuration("timeout")))
+		assertz.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), discard(c.GetOptionTime("since")))
+		assertz.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), discard(c.GetOptionTime("date")))
+		assertz.Equal(t, netip.MustParseAddr("2001:db8::1"), discard(c.GetOptionIP("addr")))
+		assertz.Equal(t, netip.MustParsePrefix("192.0.2.0/24"), discard(c.GetOptionPrefix("cidr")))
+		assertz.Equal(t, "https://example.com/api", discard(c.GetOptionURL("endpoint")).String())
+		assertz.Equal(t, "yaml", discard(c.GetOptionEnum("format")))
+	})
+
+	t.Run("success,TypedOption,default", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&DurationOption{Name: "timeout", Default: time.Second},
+				&URLOption{Name: "endpoint", Default: &url.URL{Scheme: "https", Host: "example.com"}},
+				&URLOption{Name: "proxy"},
+				&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}, Default: "json"},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, time.Second, discard(c.GetOptionDuration("timeout")))
+		assertz.Equal(t, "https://example.com", discard(c.GetOptionURL("endpoint")).String())
+		assertz.Equal(t, "", discard(c.GetOptionURL("proxy")).String())
+		assertz.Equal(t, "json", discard(c.GetOptionEnum("format")))
+	})
+
+	t.Run("error,TypedOption", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			option Option
+			osArgs []string
+			errMsg string
+		}{
+			{name: "Duration", option: &DurationOption{Name: "timeout"}, osArgs: []string{"--timeout", "FAILURE"}, errMsg: `--timeout: time: invalid duration "FAILURE"`},
+			{name: "Time", option: &TimeOption{Name: "since"}, osArgs: []string{"--since=FAILURE"}, errMsg: `--since=FAILURE: parsing time "FAILURE"`},
+			{name: "IP", option: &IPOption{Name: "addr"}, osArgs: []string{"--addr", "FAILURE"}, errMsg: `--addr: ParseAddr("FAILURE")`},
+			{name: "Prefix", option: &PrefixOption{Name: "cidr"}, osArgs: []string{"--cidr", "192.0.2.0"}, errMsg: `--cidr: netip.ParsePrefix("192.0.2.0"): no '/'`},
+			{name: "URL", option: &URLOption{Name: "endpoint"}, osArgs: []string{"--endpoint", "/api"}, errMsg: `--endpoint: "/api": not an absolute URL: invalid option value`},
+			{name: "Enum", option: &EnumOption{Name: "format", Allowed: []string{"json", "yaml"}}, osArgs: []string{"--format", "xml"}, errMsg: `--format: "xml": must be one of json|yaml: invalid option value`},
+			{name: "MissingOptionValue", option: &DurationOption{Name: "timeout"}, osArgs: []string{"--timeout"}, errMsg: `--timeout: missing option value`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := &Command{Name: "main-cli", Options: []Option{tt.option}}
+				_, err := c.parseArgs(tt.osArgs)
+				requirez.ErrorContains(t, err, tt.errMsg)
+			})
+		}
+	})
+
+	t.Run("error,EnumOption,default", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", Options: []Option{&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}, Default: "xml"}}}
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+	})
+
+	t.Run("success,POSIXStyle", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			osArgs  []string
+			verbose bool
+			force   bool
+			num     int64
+			output  string
+			args    []string
+		}{
+			{name: "cluster", osArgs: []string{"main-cli", "sub-cmd", "-vf"}, verbose: true, force: true, num: 1, output: "default", args: []string{}},
+			{name: "attached", osArgs: []string{"main-cli", "sub-cmd", "-n5", "-ofile.txt"}, num: 5, output: "file.txt", args: []string{}},
+			{name: "cluster,attached", osArgs: []string{"main-cli", "sub-cmd", "-vfn5"}, verbose: true, force: true, num: 5, output: "default", args: []string{}},
+			{name: "cluster,separated", osArgs: []string{"main-cli", "sub-cmd", "-vo", "file.txt", "arg"}, verbose: true, num: 1, output: "file.txt", args: []string{"arg"}},
+			{name: "negated", osArgs: []string{"main-cli", "sub-cmd", "-vf", "--no-verbose", "--no-f"}, num: 1, output: "default", args: []string{}},
+			{name: "default_style", osArgs: []string{"main-cli", "sub-cmd", "--verbose", "-n", "3", "--output=out"}, verbose: true, num: 3, output: "out", args: []string{}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := &Command{
+					Name:       "main-cli",
+					POSIXStyle: true,
+					SubCommands: []*Command{
+						{
+							Name: "sub-cmd",
+							Options: []Option{
+								&BoolOption{Name: "verbose", Aliases: []string{"v"}},
+								&BoolOption{Name: "force", Aliases: []string{"f"}},
+								&Int64Option{Name: "num", Aliases: []string{"n"}, Default: 1},
+								&StringOption{Name: "output", Aliases: []string{"o"}, Default: "default"},
+							},
+						},
+					},
+				}
+				args, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+				assertz.Equal(t, tt.args, args)
+				assertz.Equal(t, tt.verbose, discard(c.GetOptionBool("verbose")))
+				assertz.Equal(t, tt.force, discard(c.GetOptionBool("force")))
+				assertz.Equal(t, tt.num, discard(c.GetOptionInt64("num")))
+				assertz.Equal(t, tt.output, discard(c.GetOptionString("output")))
+			})
+		}
+	})
+
+	t.Run("error,POSIXStyle", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name       string
+			posixStyle bool
+			osArgs     []string
+			err        error
+			errMsg     string
+		}{
+			{name: "disabled,cluster", osArgs: []string{"-vf"}, err: ErrUnknownOption, errMsg: "-vf: unknown option"},
+			{name: "disabled,negated", osArgs: []string{"--no-verbose"}, err: ErrUnknownOption, errMsg: "--no-verbose: unknown option"},
+			{name: "unknown", posixStyle: true, osArgs: []string{"-vx"}, err: ErrUnknownOption, errMsg: "-x: unknown option"},
+			{name: "missing", posixStyle: true, osArgs: []string{"-vn"}, err: ErrMissingOptionValue, errMsg: "-vn: -n: missing option value"},
+			{name: "invalid", posixStyle: true, osArgs: []string{"-nFAILURE"}, errMsg: `-nFAILURE: -n: strconv.ParseInt: parsing "FAILURE": invalid syntax`},
+			{name: "negated,not_bool", posixStyle: true, osArgs: []string{"--no-num"}, err: ErrUnknownOption, errMsg: "--no-num: unknown option"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := &Command{
+					Name:       "main-cli",
+					POSIXStyle: tt.posixStyle,
+					Options: []Option{
+						&BoolOption{Name: "verbose", Aliases: []string{"v"}},
+						&Int64Option{Name: "num", Aliases: []string{"n"}},
+					},
+				}
+				_, err := c.parseArgs(tt.osArgs)
+				if tt.err != nil {
+					requirez.ErrorIs(t, err, tt.err)
+				}
+				requirez.ErrorContains(t, err, tt.errMsg)
+			})
+		}
+	})
 }
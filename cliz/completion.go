@@ -1,14 +1,16 @@
 package cliz
 
 import (
+	"embed"
+	"strings"
 	"text/template"
 
 	"github.com/hakadoriya/z.go/errorz"
 )
 
-type completionBashTmplData struct {
-	RootCommandName                      string
-	GenerateBashCompletionSubCommandName string
+type completionTmplData struct {
+	RootCommandName                  string
+	GenerateCompletionSubCommandName string
 }
 
 func (c *Command) initAppendCompletionSubCommand() {
@@ -19,21 +21,215 @@ func (c *Command) initAppendCompletionSubCommand() {
 			Name:   DefaultCompletionSubCommandName,
 			Hidden: true,
 			SubCommands: []*Command{
-				{
-					Name:        "bash",
-					Description: "generate bash completion script",
-					ExecFunc: func(cmd *Command, _ []string) error {
-						b, err := completionBashTmplFile.ReadFile(completionBashTmpl)
-						if err != nil {
-							return errorz.Errorf("completionBashTmplFile.ReadFile: name=%s: %w", completionBashTmpl, err)
-						}
-						tmpl := template.Must(template.New(completionBashTmpl).Parse(string(b)))
-						return tmpl.Execute(cmd.Stdout(), completionBashTmplData{
-							RootCommandName:                      c.Name,
-							GenerateBashCompletionSubCommandName: DefaultGenerateBashCompletionSubCommandName,
-						})
-					},
-				},
+				c.newCompletionScriptSubCommand("bash", "generate bash completion script", completionBashTmplFile, completionBashTmpl, DefaultGenerateBashCompletionSubCommandName),
+				c.newCompletionScriptSubCommand("zsh", "generate zsh completion script", completionZshTmplFile, completionZshTmpl, DefaultGenerateZshCompletionSubCommandName),
+				c.newCompletionScriptSubCommand("fish", "generate fish completion script", completionFishTmplFile, completionFishTmpl, DefaultGenerateFishCompletionSubCommandName),
+				c.newCompletionScriptSubCommand("powershell", "generate powershell completion script", completionPowerShellTmplFile, completionPowerShellTmpl, DefaultGeneratePowerShellCompletionSubCommandName),
 			},
 		})
 }
+
+func (c *Command) newCompletionScriptSubCommand(shell, description string, tmplFile embed.FS, tmplName, generateCompletionSubCommandName string) *Command {
+	//nolint:exhaustruct
+	return &Command{
+		Name:        shell,
+		Description: description,
+		ExecFunc: func(cmd *Command, _ []string) error {
+			b, err := tmplFile.ReadFile(tmplName)
+			if err != nil {
+				return errorz.Errorf("%s: ReadFile: name=%s: %w", shell, tmplName, err)
+			}
+			tmpl := template.Must(template.New(tmplName).Parse(string(b)))
+			return tmpl.Execute(cmd.Stdout(), completionTmplData{
+				RootCommandName:                  c.Name,
+				GenerateCompletionSubCommandName: generateCompletionSubCommandName,
+			})
+		},
+	}
+}
+
+// initAppendGenerateCompletionSubCommands appends the hidden subcommands that the completion scripts call back.
+//
+// The completion scripts call them as `<root> <generator> -- <words...> <word under the cursor>`,
+// and the generators walk the command tree along the words by themselves,
+// so that they can complete the values of options and positional arguments.
+func (c *Command) initAppendGenerateCompletionSubCommands() {
+	c.SubCommands = append(
+		c.SubCommands,
+		c.newGenerateBashCompletionSubCommand(),
+		c.newGenerateZshCompletionSubCommand(),
+		c.newGenerateFishCompletionSubCommand(),
+		c.newGeneratePowerShellCompletionSubCommand(),
+	)
+}
+
+type completionCandidate struct {
+	value       string
+	description string
+}
+
+// getCompletionCandidatesForWords returns the completion candidates for the last element of words.
+// words are the words typed on the command line except the root command name.
+//
+//nolint:cyclop
+func (c *Command) getCompletionCandidatesForWords(words []string) []completionCandidate {
+	toComplete := ""
+	if len(words) > 0 {
+		toComplete = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	cmd := c
+	args := make([]string, 0)
+	var pending Option
+	afterBreak := false
+	for _, word := range words {
+		switch {
+		case pending != nil:
+			// NOTE: bash splits `--option=value` into `--option`, `=` and `value`.
+			if word == "=" {
+				continue
+			}
+			pending = nil
+		case afterBreak:
+			args = append(args, word)
+		case word == breakArg:
+			afterBreak = true
+		case strings.HasPrefix(word, shortOptionPrefix):
+			if opt := cmd.getOptionByHyphenArg(word); opt != nil && optionTakesValue(opt) {
+				pending = opt
+			}
+		default:
+			if subcmd := cmd.getSubcommand(word); subcmd != nil {
+				cmd = subcmd
+				args = args[:0]
+				continue
+			}
+			// NOTE: The arguments of the plugin are unknown.
+			if len(args) == 0 && cmd.getPlugin(word) != nil {
+				return nil
+			}
+			args = append(args, word)
+		}
+	}
+
+	if pending != nil {
+		return completionCandidatesFromValues("", completeOption(cmd, pending, toComplete))
+	}
+
+	if afterBreak {
+		return completionCandidatesFromValues("", completeArgs(cmd, args, toComplete))
+	}
+
+	if strings.HasPrefix(toComplete, shortOptionPrefix) {
+		if name, value, ok := strings.Cut(toComplete, "="); ok {
+			for _, opt := range cmd.getAcceptedOptions() {
+				if argIsHyphenOptionEqual(opt, toComplete) {
+					return completionCandidatesFromValues(name+"=", completeOption(cmd, opt, value))
+				}
+			}
+			return nil
+		}
+		return cmd.getCompletionCandidates()
+	}
+
+	return append(cmd.getCompletionCandidates(), completionCandidatesFromValues("", completeArgs(cmd, args, toComplete))...)
+}
+
+// getOptionByHyphenArg returns the option that matches `--long` or `-s`.
+func (c *Command) getOptionByHyphenArg(osArg string) Option {
+	for _, opt := range c.getAcceptedOptions() {
+		if argIsHyphenOption(opt, osArg) {
+			return opt
+		}
+	}
+	return nil
+}
+
+// optionTakesValue returns whether the option consumes the next argument as its value.
+func optionTakesValue(opt Option) bool {
+	o, ok := opt.(optionValue)
+	return ok && !o.isBoolFlag()
+}
+
+func completeOption(c *Command, opt Option, toComplete string) []string {
+	o, ok := opt.(optionValue)
+	if !ok {
+		return nil
+	}
+	return o.complete(c, toComplete)
+}
+
+// completeOptionValue calls CompleteFunc of the option, if it is set.
+func completeOptionValue(completeFunc func(c *Command, toComplete string) []string, c *Command, toComplete string) []string {
+	if completeFunc == nil {
+		return nil
+	}
+	return completeFunc(c, toComplete)
+}
+
+func completeArgs(c *Command, args []string, toComplete string) []string {
+	if c.CompleteFunc != nil {
+		return c.CompleteFunc(c, args, toComplete)
+	}
+
+	// NOTE: If CompleteFunc of the command is not set, complete by Args.
+	arg := c.getArgAt(len(args))
+	switch {
+	case arg == nil:
+		return nil
+	case arg.CompleteFunc != nil:
+		return arg.CompleteFunc(c, toComplete)
+	case arg.Type == ArgTypeBool:
+		return []string{"true", "false"}
+	default:
+		return nil
+	}
+}
+
+func completionCandidatesFromValues(prefix string, values []string) []completionCandidate {
+	candidates := make([]completionCandidate, 0, len(values))
+	for _, value := range values {
+		candidates = append(candidates, completionCandidate{value: prefix + value, description: ""})
+	}
+	return candidates
+}
+
+// getCompletionCandidates returns the subcommand names, aliases and option names that can follow the command.
+func (c *Command) getCompletionCandidates() []completionCandidate {
+	candidates := make([]completionCandidate, 0)
+	for _, subcmd := range c.SubCommands {
+		if subcmd.Hidden || subcmd.Deprecated != nil {
+			continue
+		}
+
+		description := completionDescription(subcmd.Description)
+		candidates = append(candidates, completionCandidate{value: subcmd.Name, description: description})
+		for _, alias := range subcmd.Aliases {
+			candidates = append(candidates, completionCandidate{value: alias, description: description})
+		}
+	}
+
+	for _, p := range c.discoverPlugins() {
+		candidates = append(candidates, completionCandidate{value: p.name, description: "plugin " + p.path})
+	}
+
+	for _, option := range c.getAcceptedOptions() {
+		if option.IsHidden() || option.GetDeprecated() != nil {
+			continue
+		}
+
+		description := completionDescription(option.GetDescription())
+		candidates = append(candidates, completionCandidate{value: longOptionPrefix + option.GetName(), description: description})
+		for _, alias := range option.GetAliases() {
+			candidates = append(candidates, completionCandidate{value: shortOptionPrefix + alias, description: description})
+		}
+	}
+
+	return candidates
+}
+
+// completionDescription folds the description into a single line so that it does not break the line-oriented completion protocols.
+func completionDescription(description string) string {
+	return strings.Join(strings.Fields(description), " ")
+}
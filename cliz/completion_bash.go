@@ -13,38 +13,20 @@ var (
 	completionBashTmpl     = "completion_bash.tmpl"
 )
 
-func (c *Command) initAppendGenerateBashCompletionSubCommands() {
-	// recursively
-	for _, subcmd := range c.SubCommands {
-		subcmd.initAppendGenerateBashCompletionSubCommands()
-	}
-
-	c.SubCommands = append(
-		c.SubCommands,
-		//nolint:exhaustruct
-		&Command{
-			Name:   DefaultGenerateBashCompletionSubCommandName,
-			Hidden: true,
-			ExecFunc: func(_ *Command, _ []string) error {
-				completions := make([]string, 0)
-				for _, subcmd := range c.SubCommands {
-					if subcmd.Hidden {
-						continue
-					}
-
-					completions = append(completions, subcmd.Name)
-					completions = append(completions, subcmd.Aliases...)
-				}
+func (c *Command) newGenerateBashCompletionSubCommand() *Command {
+	//nolint:exhaustruct
+	return &Command{
+		Name:                DefaultGenerateBashCompletionSubCommandName,
+		Hidden:              true,
+		completionGenerator: true,
+		ExecFunc: func(_ *Command, args []string) error {
+			completions := make([]string, 0)
+			for _, candidate := range c.getCompletionCandidatesForWords(args) {
+				completions = append(completions, candidate.value)
+			}
 
-				for _, option := range c.Options {
-					completions = append(completions, longOptionPrefix+option.GetName())
-					for _, alias := range option.GetAliases() {
-						completions = append(completions, shortOptionPrefix+alias)
-					}
-				}
-
-				_, _ = io.WriteString(c.Stdout(), strings.Join(completions, " ")+"\n")
-				return nil
-			},
-		})
+			_, _ = io.WriteString(c.Stdout(), strings.Join(completions, " ")+"\n")
+			return nil
+		},
+	}
 }
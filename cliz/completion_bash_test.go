@@ -8,7 +8,7 @@ import (
 	"github.com/hakadoriya/z.go/testingz/requirez"
 )
 
-func TestCommand_initAppendGenerateBashCompletionSubCommands(t *testing.T) {
+func TestCommand_newGenerateBashCompletionSubCommand(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -18,7 +18,38 @@ func TestCommand_initAppendGenerateBashCompletionSubCommands(t *testing.T) {
 		buf := new(bytes.Buffer)
 		c.SetStdout(buf)
 		ctx := context.Background()
-		err := c.Exec(ctx, []string{"main-cli", "sub-cmd", DefaultGenerateBashCompletionSubCommandName})
+		err := c.Exec(ctx, []string{"main-cli", DefaultGenerateBashCompletionSubCommandName, "--", "sub-cmd", ""})
 		requirez.NoError(t, err)
+		requirez.Equal(t, "sub-sub-cmd sub-sub sub-sub-cmd2 sub-sub2 sub-sub-cmd3 sub-sub3 sub-sub-cmd4 sub-sub4 --bar --alias -a --string-opt3 --bool-opt3 --int64-opt3 --uint64-opt3 --float64-opt3 --help\n", buf.String())
+	})
+}
+
+func TestCommand_newGenerateBashCompletionSubCommand_requiredOption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: true}
+		//nolint:exhaustruct
+		c := &Command{
+			Name:        "main-cli",
+			Interactive: true,
+			Prompter:    p,
+			Confirm:     "Are you sure?",
+			Options: []Option{
+				&StringOption{Name: "token", Required: true, Description: "token"},
+			},
+			SubCommands: []*Command{
+				{Name: "sub-cmd", Options: []Option{&StringOption{Name: "name", Required: true, Description: "name"}}, ExecFunc: func(_ *Command, _ []string) error { return nil }},
+			},
+			ExecFunc: func(_ *Command, _ []string) error { return nil },
+		}
+		buf := new(bytes.Buffer)
+		c.SetStdout(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", DefaultGenerateBashCompletionSubCommandName, "--", "sub-cmd", "--"})
+		requirez.NoError(t, err)
+		requirez.Equal(t, "--name --help\n", buf.String())
+		requirez.Equal(t, 0, len(p.messages))
 	})
 }
@@ -0,0 +1,29 @@
+package cliz
+
+import (
+	"embed"
+	"io"
+)
+
+//nolint:gochecknoglobals
+var (
+	//go:embed completion_fish.tmpl
+	completionFishTmplFile embed.FS
+	completionFishTmpl     = "completion_fish.tmpl"
+)
+
+func (c *Command) newGenerateFishCompletionSubCommand() *Command {
+	//nolint:exhaustruct
+	return &Command{
+		Name:                DefaultGenerateFishCompletionSubCommandName,
+		Hidden:              true,
+		completionGenerator: true,
+		ExecFunc: func(_ *Command, args []string) error {
+			// NOTE: fish treats the string after the tab as the description of the candidate.
+			for _, candidate := range c.getCompletionCandidatesForWords(args) {
+				_, _ = io.WriteString(c.Stdout(), candidate.value+"\t"+candidate.description+"\n")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_newGenerateFishCompletionSubCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdoutRecursive(buf)
+		ctx := context.Background()
+		err := c.Exec(ctx, []string{"main-cli", DefaultGenerateFishCompletionSubCommandName, "--", ""})
+		requirez.NoError(t, err)
+		requirez.StringContains(t, buf.String(), "sub-cmd\tmy sub command\n")
+		requirez.StringContains(t, buf.String(), "--string-opt\tmy string-opt option\n")
+		requirez.False(t, bytes.Contains(buf.Bytes(), []byte("hidden-opt")))
+		requirez.False(t, bytes.Contains(buf.Bytes(), []byte(DefaultCompletionSubCommandName)))
+	})
+}
@@ -0,0 +1,29 @@
+package cliz
+
+import (
+	"embed"
+	"io"
+)
+
+//nolint:gochecknoglobals
+var (
+	//go:embed completion_powershell.tmpl
+	completionPowerShellTmplFile embed.FS
+	completionPowerShellTmpl     = "completion_powershell.tmpl"
+)
+
+func (c *Command) newGeneratePowerShellCompletionSubCommand() *Command {
+	//nolint:exhaustruct
+	return &Command{
+		Name:                DefaultGeneratePowerShellCompletionSubCommandName,
+		Hidden:              true,
+		completionGenerator: true,
+		ExecFunc: func(_ *Command, args []string) error {
+			// NOTE: The completion script splits each line at the first tab into the value and the tooltip.
+			for _, candidate := range c.getCompletionCandidatesForWords(args) {
+				_, _ = io.WriteString(c.Stdout(), candidate.value+"\t"+candidate.description+"\n")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_newGeneratePowerShellCompletionSubCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdoutRecursive(buf)
+		ctx := context.Background()
+		err := c.Exec(ctx, []string{"main-cli", DefaultGeneratePowerShellCompletionSubCommandName, "--", ""})
+		requirez.NoError(t, err)
+		requirez.StringContains(t, buf.String(), "sub-cmd\tmy sub command\n")
+		requirez.StringContains(t, buf.String(), "sub\tmy sub command\n")
+		requirez.StringContains(t, buf.String(), "-s\tmy string-opt option\n")
+	})
+}
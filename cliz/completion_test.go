@@ -3,6 +3,8 @@ package cliz
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hakadoriya/z.go/testingz/assertz"
@@ -23,6 +25,40 @@ func TestCommand_initAppendCompletionSubCommand(t *testing.T) {
 		assertz.StringContains(t, buf.String(), DefaultGenerateBashCompletionSubCommandName)
 	})
 
+	t.Run("success,zsh", func(t *testing.T) {
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdout(buf)
+		c.SetStderr(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "completion", "zsh"})
+		requirez.NoError(t, err)
+		assertz.StringHasPrefix(t, buf.String(), "#compdef main-cli\n")
+		assertz.StringContains(t, buf.String(), DefaultGenerateZshCompletionSubCommandName)
+		assertz.StringContains(t, buf.String(), "compdef __cliz_completion_zsh main-cli\n")
+	})
+
+	t.Run("success,fish", func(t *testing.T) {
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdout(buf)
+		c.SetStderr(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "completion", "fish"})
+		requirez.NoError(t, err)
+		assertz.StringContains(t, buf.String(), DefaultGenerateFishCompletionSubCommandName)
+		assertz.StringContains(t, buf.String(), "complete -c main-cli -f -a '(__cliz_completion_fish)'\n")
+	})
+
+	t.Run("success,powershell", func(t *testing.T) {
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdout(buf)
+		c.SetStderr(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "completion", "powershell"})
+		requirez.NoError(t, err)
+		assertz.StringContains(t, buf.String(), DefaultGeneratePowerShellCompletionSubCommandName)
+		assertz.StringContains(t, buf.String(), "Register-ArgumentCompleter -Native -CommandName 'main-cli'")
+	})
+
 	t.Run("failure,open_invalid_file_does_not_exist", func(t *testing.T) {
 		c := newTestCommand()
 		backup := completionBashTmpl
@@ -32,3 +68,90 @@ func TestCommand_initAppendCompletionSubCommand(t *testing.T) {
 		requirez.ErrorContains(t, err, `open invalid: file does not exist`)
 	})
 }
+
+type testCompletableValue struct{ value string }
+
+func (v *testCompletableValue) String() string     { return v.value }
+func (v *testCompletableValue) Set(s string) error { v.value = s; return nil }
+func (v *testCompletableValue) Type() string       { return "level" }
+func (v *testCompletableValue) Complete(toComplete string) []string {
+	candidates := make([]string, 0)
+	for _, level := range []string{"debug", "info", "warn", "error"} {
+		if strings.HasPrefix(level, toComplete) {
+			candidates = append(candidates, level)
+		}
+	}
+	return candidates
+}
+
+func TestCommand_getCompletionCandidatesForWords(t *testing.T) {
+	t.Parallel()
+
+	newCommand := func() *Command {
+		return &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&StringOption{
+					Name:    "env",
+					Aliases: []string{"e"},
+					CompleteFunc: func(_ *Command, toComplete string) []string {
+						return []string{"dev", "prd", "toComplete=" + toComplete}
+					},
+				},
+				&StringOption{Name: "no-complete"},
+				&BoolOption{Name: "verbose", Description: "verbose output"},
+				&Int64Option{Name: "num", CompleteFunc: func(_ *Command, _ string) []string { return []string{"1", "10"} }},
+				&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}},
+				&ValueOption{Name: "level", Value: &testCompletableValue{}},
+			},
+			SubCommands: []*Command{
+				{
+					Name:        "get",
+					Description: "get resources",
+					CompleteFunc: func(_ *Command, args []string, toComplete string) []string {
+						return []string{fmt.Sprintf("args=%v", args), "toComplete=" + toComplete}
+					},
+				},
+			},
+		}
+	}
+
+	values := func(candidates []completionCandidate) []string {
+		values := make([]string, 0, len(candidates))
+		for _, candidate := range candidates {
+			values = append(values, candidate.value)
+		}
+		return values
+	}
+
+	tests := []struct {
+		name     string
+		words    []string
+		expected []string
+	}{
+		{name: "success,empty", words: nil, expected: []string{"get", "--env", "-e", "--no-complete", "--verbose", "--num", "--format", "--level"}},
+		{name: "success,option_value", words: []string{"--env", "p"}, expected: []string{"dev", "prd", "toComplete=p"}},
+		{name: "success,option_alias_value", words: []string{"-e", ""}, expected: []string{"dev", "prd", "toComplete="}},
+		{name: "success,option_value_bash_equal", words: []string{"--env", "=", "p"}, expected: []string{"dev", "prd", "toComplete=p"}},
+		{name: "success,option_value_equal", words: []string{"--env=p"}, expected: []string{"--env=dev", "--env=prd", "--env=toComplete=p"}},
+		{name: "success,option_value_no_complete", words: []string{"--no-complete", ""}, expected: []string{}},
+		{name: "success,option_value_consumed", words: []string{"--env", "dev", ""}, expected: []string{"get", "--env", "-e", "--no-complete", "--verbose", "--num", "--format", "--level"}},
+		{name: "success,bool_option", words: []string{"--verbose", ""}, expected: []string{"get", "--env", "-e", "--no-complete", "--verbose", "--num", "--format", "--level"}},
+		{name: "success,int64_option_value", words: []string{"--num", ""}, expected: []string{"1", "10"}},
+		{name: "success,enum_option_value", words: []string{"--format", ""}, expected: []string{"json", "yaml"}},
+		{name: "success,completable_value", words: []string{"--level=w"}, expected: []string{"--level=warn"}},
+		{name: "success,option_name", words: []string{"get", "--"}, expected: []string{}},
+		{name: "success,args", words: []string{"get", "a", "b", "c"}, expected: []string{"args=[a b]", "toComplete=c"}},
+		{name: "success,args_after_break", words: []string{"get", "--", "--env", ""}, expected: []string{"args=[--env]", "toComplete="}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCommand()
+			actual := values(c.getCompletionCandidatesForWords(tt.words))
+			requirez.Equal(t, tt.expected, actual)
+		})
+	}
+}
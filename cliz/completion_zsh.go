@@ -1,3 +1,34 @@
 package cliz
 
-// TODO: implement
+import (
+	"embed"
+	"io"
+	"strings"
+)
+
+//nolint:gochecknoglobals
+var (
+	//go:embed completion_zsh.tmpl
+	completionZshTmplFile embed.FS
+	completionZshTmpl     = "completion_zsh.tmpl"
+)
+
+func (c *Command) newGenerateZshCompletionSubCommand() *Command {
+	//nolint:exhaustruct
+	return &Command{
+		Name:                DefaultGenerateZshCompletionSubCommandName,
+		Hidden:              true,
+		completionGenerator: true,
+		ExecFunc: func(_ *Command, args []string) error {
+			// NOTE: `_describe` uses ":" as the separator of the value and the description, so ":" in the value must be escaped.
+			for _, candidate := range c.getCompletionCandidatesForWords(args) {
+				completion := strings.ReplaceAll(candidate.value, ":", `\:`)
+				if candidate.description != "" {
+					completion += ":" + candidate.description
+				}
+				_, _ = io.WriteString(c.Stdout(), completion+"\n")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_newGenerateZshCompletionSubCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdoutRecursive(buf)
+		ctx := context.Background()
+		err := c.Exec(ctx, []string{"main-cli", DefaultGenerateZshCompletionSubCommandName, "--", "sub-cmd", ""})
+		requirez.NoError(t, err)
+		requirez.StringContains(t, buf.String(), "sub-sub-cmd:my sub-sub command\n")
+		requirez.StringContains(t, buf.String(), "sub-sub:my sub-sub command\n")
+		requirez.StringContains(t, buf.String(), "--alias:my alias option\n")
+		requirez.StringContains(t, buf.String(), "-a:my alias option\n")
+	})
+}
@@ -0,0 +1,302 @@
+package cliz
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// ConfigFile is the config file source of the option values.
+	//
+	// The config file is keyed by the subcommand names and the option names, like the following YAML:
+	//
+	//	# the option of the root command
+	//	log-level: debug
+	//	# the options of `main-cli sub-cmd`
+	//	sub-cmd:
+	//	  num: 5
+	//	  tags: [a, b]
+	ConfigFile struct {
+		// OptionName is the name of the option to specify the config file path.
+		//
+		// If OptionName is empty, DefaultConfigFileOptionName is used.
+		OptionName string
+		// Aliases is the alias names of the option to specify the config file path.
+		Aliases []string
+		// Env is the environment variable name of the option to specify the config file path.
+		Env string
+		// Default is the default config file path.
+		//
+		// If the file of the default path does not exist, it is ignored.
+		Default string
+		// Decoders is the decoders of the config file formats, keyed by the file extension like ".toml".
+		//
+		// JSON (".json") and YAML (".yaml", ".yml") are supported without Decoders.
+		// Decoders can override them.
+		Decoders map[string]ConfigDecoder
+	}
+
+	// ConfigDecoder decodes the content of the config file to the map keyed by the subcommand names and the option names.
+	//
+	// The value of the map is a nested map for a subcommand, a slice for a slice option, or a scalar for the other options.
+	ConfigDecoder func(data []byte) (map[string]interface{}, error)
+)
+
+//nolint:gochecknoglobals
+var builtinConfigDecoders = map[string]ConfigDecoder{
+	".json": decodeJSONConfig,
+	".yaml": decodeYAMLConfig,
+	".yml":  decodeYAMLConfig,
+}
+
+func (cf *ConfigFile) getOptionName() string {
+	if cf.OptionName != "" {
+		return cf.OptionName
+	}
+	return DefaultConfigFileOptionName
+}
+
+func (cf *ConfigFile) getDecoder(path string) (ConfigDecoder, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if decoder, ok := cf.Decoders[ext]; ok {
+		return decoder, nil
+	}
+	if decoder, ok := builtinConfigDecoders[ext]; ok {
+		return decoder, nil
+	}
+	return nil, errorz.Errorf("%s: %w", path, ErrUnsupportedConfigFormat)
+}
+
+func (c *Command) initAppendConfigFileOption() {
+	if c.ConfigFile == nil {
+		return
+	}
+
+	// If config file option is already set, do nothing.
+	if _, ok := c.getConfigFileOption(); ok {
+		return
+	}
+
+	//nolint:exhaustruct
+	c.Options = append(c.Options, &StringOption{
+		Name:        c.ConfigFile.getOptionName(),
+		Aliases:     c.ConfigFile.Aliases,
+		Env:         c.ConfigFile.Env,
+		Default:     c.ConfigFile.Default,
+		Description: "config file path",
+	})
+}
+
+func (c *Command) getConfigFileOption() (configFileOption *StringOption, ok bool) {
+	if c.ConfigFile == nil {
+		return nil, false
+	}
+
+	for _, opt := range c.Options {
+		if o, ok := opt.(*StringOption); ok && o.Name == c.ConfigFile.getOptionName() {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// lookupConfigFilePath returns the config file path in the precedence: command line arguments > environment variable > default.
+// The config file must be loaded before the command line arguments are parsed, so it looks up osArgs by itself.
+// Like the parser, it looks up the arguments of the command only, i.e. before `--` and the subcommand.
+func (c *Command) lookupConfigFilePath(o *StringOption, osArgs []string) (path string, isDefault bool) {
+	// NOTE: osArgs[0] is the program name.
+argsLoop:
+	for i := 1; i < len(osArgs); i++ {
+		osArg := osArgs[i]
+		switch {
+		case osArg == breakArg:
+			break argsLoop
+		case argIsHyphenOption(o, osArg):
+			if !hasNoOptionValue(osArgs, i) {
+				path = osArgs[i+1]
+				i++
+			}
+		case argIsHyphenOptionEqual(o, osArg):
+			path = extractValueFromHyphenOptionEqual(osArg)
+		case strings.HasPrefix(osArg, shortOptionPrefix):
+			// NOTE: Skip the value of the other option, so that the value is not taken as the subcommand.
+			if c.isValueOptionArg(osArg) {
+				i++
+			}
+		case c.getSubcommand(osArg) != nil:
+			break argsLoop
+		}
+	}
+	if path != "" {
+		return path, false
+	}
+
+	if o.Env != "" {
+		if s := c.Getenv(o.Env); s != "" {
+			return s, false
+		}
+	}
+
+	return o.Default, true
+}
+
+// isValueOptionArg reports whether osArg is the option of the command which takes the value from the next argument.
+func (c *Command) isValueOptionArg(osArg string) bool {
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(optionValue); ok && argIsHyphenOption(o, osArg) {
+			return !o.isBoolFlag()
+		}
+	}
+	return false
+}
+
+func (c *Command) loadConfigFile(osArgs []string) error {
+	o, ok := c.getConfigFileOption()
+	if !ok {
+		return nil
+	}
+
+	path, isDefault := c.lookupConfigFilePath(o, osArgs)
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if isDefault && errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return errorz.Errorf("os.ReadFile: %w", err)
+	}
+
+	decoder, err := c.ConfigFile.getDecoder(path)
+	if err != nil {
+		return err
+	}
+
+	config, err := decoder(data)
+	if err != nil {
+		return errorz.Errorf("%s: decode: %w", path, err)
+	}
+
+	c.configFilePath = path
+	if err := c.loadConfig(config, path); err != nil {
+		return errorz.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+//nolint:cyclop
+func (c *Command) loadConfig(config map[string]interface{}, path string) error {
+	for key, value := range config {
+		if o := c.getOptionValueByName(key); o != nil {
+			if value == nil {
+				continue
+			}
+			s, err := configValueToString(value)
+			if err != nil {
+				return errorz.Errorf("%s: %w", key, err)
+			}
+			resetOptionValue(o)
+			if err := o.setValue(s); err != nil {
+				return errorz.Errorf("%s: %w", key, maskOptionError(o, s, err))
+			}
+			c.setOptionSource(o, OptionSourceConfigFile)
+			continue
+		}
+
+		if subcmd := c.getSubcommand(key); subcmd != nil {
+			if value == nil {
+				continue
+			}
+			subConfig, ok := value.(map[string]interface{})
+			if !ok {
+				return errorz.Errorf("%s: %T: %w", key, value, ErrInvalidOptionValue)
+			}
+			subcmd.configFilePath = path
+			if err := subcmd.loadConfig(subConfig, path); err != nil {
+				return errorz.Errorf("%s: %w", key, err)
+			}
+			continue
+		}
+
+		return errorz.Errorf("%s: %w", key, ErrUnknownConfigKey)
+	}
+
+	return nil
+}
+
+func (c *Command) getOptionValueByName(name string) optionValue {
+	for _, opt := range c.Options {
+		if o, ok := opt.(optionValue); ok && o.GetName() == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// configValueToString converts the value of the config to the string for (optionValue).setValue.
+// The slice is converted to a CSV record, which is the format of the slice option value.
+func configValueToString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case []interface{}:
+		record := make([]string, 0, len(v))
+		for _, elem := range v {
+			s, err := configScalarToString(elem)
+			if err != nil {
+				return "", err
+			}
+			record = append(record, s)
+		}
+		buf := new(bytes.Buffer)
+		w := csv.NewWriter(buf)
+		if err := w.Write(record); err != nil {
+			return "", errorz.Errorf("csv.Writer.Write: %w", err)
+		}
+		w.Flush()
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+	default:
+		return configScalarToString(v)
+	}
+}
+
+func configScalarToString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	case nil:
+		return "", nil
+	case map[string]interface{}, []interface{}:
+		return "", errorz.Errorf("%T: %w", v, ErrInvalidOptionValue)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func decodeJSONConfig(data []byte) (map[string]interface{}, error) {
+	config := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// NOTE: UseNumber keeps the precision of the large integers.
+	dec.UseNumber()
+	if err := dec.Decode(&config); err != nil {
+		return nil, errorz.Errorf("json.Decoder.Decode: %w", err)
+	}
+	return config, nil
+}
@@ -0,0 +1,175 @@
+package cliz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestConfigFileCommand(configFile *ConfigFile) *Command {
+	return &Command{
+		Name:       "main-cli",
+		ConfigFile: configFile,
+		Options: []Option{
+			&StringOption{Name: "log-level", Env: "TEST_CONFIG_LOG_LEVEL", Default: "info"},
+		},
+		SubCommands: []*Command{
+			{
+				Name: "sub-cmd",
+				Options: []Option{
+					&Int64Option{Name: "num", Default: 1},
+					&BoolOption{Name: "verbose"},
+					&StringSliceOption{Name: "tags", Default: []string{"default"}},
+				},
+			},
+		},
+	}
+}
+
+func writeTestConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	requirez.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+//nolint:paralleltest
+func TestCommand_loadConfigFile(t *testing.T) {
+	t.Run("success,YAML", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", `
+log-level: debug # comment
+sub-cmd:
+  num: 5
+  verbose: true
+  tags:
+    - a
+    - "b,c"
+`)
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path, "sub-cmd"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "debug", discard(c.GetOptionString("log-level")))
+		assertz.Equal(t, int64(5), discard(c.GetOptionInt64("num")))
+		assertz.Equal(t, true, discard(c.GetOptionBool("verbose")))
+		assertz.Equal(t, []string{"a", "b,c"}, discard(c.GetOptionStringSlice("tags")))
+	})
+
+	t.Run("success,JSON", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.json", `{"log-level": "debug", "sub-cmd": {"num": 9007199254740993, "verbose": true, "tags": ["a", "b"]}}`)
+		c := newTestConfigFileCommand(&ConfigFile{OptionName: "conf", Aliases: []string{"c"}})
+		_, err := c.parse(context.Background(), []string{"main-cli", "-c=" + path, "sub-cmd"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "debug", discard(c.GetOptionString("log-level")))
+		assertz.Equal(t, int64(9007199254740993), discard(c.GetOptionInt64("num")))
+		assertz.Equal(t, []string{"a", "b"}, discard(c.GetOptionStringSlice("tags")))
+	})
+
+	t.Run("success,precedence", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yml", "log-level: debug\nsub-cmd:\n  num: 5\n  tags: [a, b]\n")
+		t.Setenv("TEST_CONFIG_LOG_LEVEL", "warn")
+		c := newTestConfigFileCommand(&ConfigFile{Default: path})
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--num", "7"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "warn", discard(c.GetOptionString("log-level")))
+		assertz.Equal(t, int64(7), discard(c.GetOptionInt64("num")))
+		assertz.Equal(t, []string{"a", "b"}, discard(c.GetOptionStringSlice("tags")))
+	})
+
+	t.Run("error,ErrUnknownOption,after_subcommand", func(t *testing.T) {
+		// NOTE: --config of the root command is not the option of sub-cmd, so the config file is not loaded.
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--config", "not-exist.yaml"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.False(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("success,option_value_like_subcommand", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", "sub-cmd:\n  num: 5\n")
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--log-level", "sub-cmd", "--config", path, "sub-cmd", "--", "--config=not-exist.yaml"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "sub-cmd", discard(c.GetOptionString("log-level")))
+		assertz.Equal(t, int64(5), discard(c.GetOptionInt64("num")))
+	})
+
+	t.Run("success,Env", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", "log-level: debug\n")
+		t.Setenv("TEST_CONFIG_FILE", path)
+		c := newTestConfigFileCommand(&ConfigFile{Env: "TEST_CONFIG_FILE"})
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "debug", discard(c.GetOptionString("log-level")))
+	})
+
+	t.Run("success,Default,not_exist", func(t *testing.T) {
+		c := newTestConfigFileCommand(&ConfigFile{Default: filepath.Join(t.TempDir(), "not_exist.yaml")})
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "info", discard(c.GetOptionString("log-level")))
+	})
+
+	t.Run("success,Decoders", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.conf", "log-level=trace")
+		c := newTestConfigFileCommand(&ConfigFile{Decoders: map[string]ConfigDecoder{
+			".conf": func(data []byte) (map[string]interface{}, error) {
+				key, value, _ := strings.Cut(string(data), "=")
+				return map[string]interface{}{key: value}, nil
+			},
+		}})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "trace", discard(c.GetOptionString("log-level")))
+	})
+
+	t.Run("error,not_exist", func(t *testing.T) {
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", filepath.Join(t.TempDir(), "not_exist.yaml")})
+		requirez.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("error,ErrUnsupportedConfigFormat", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.toml", `log-level = "debug"`)
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.ErrorIs(t, err, ErrUnsupportedConfigFormat)
+	})
+
+	t.Run("error,ErrUnknownConfigKey", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", "sub-cmd:\n  unknown: 1\n")
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.ErrorIs(t, err, ErrUnknownConfigKey)
+		assertz.ErrorContains(t, err, "sub-cmd: unknown: unknown config key")
+	})
+
+	t.Run("error,ErrInvalidOptionValue", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.json", `{"sub-cmd": {"num": {"a": 1}}}`)
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+	})
+
+	t.Run("error,setValue", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", "sub-cmd:\n  num: FAILURE\n")
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.ErrorContains(t, err, `sub-cmd: num: strconv.ParseInt: parsing "FAILURE": invalid syntax`)
+	})
+
+	t.Run("error,decode", func(t *testing.T) {
+		errTestDecode := errors.New("test decode error")
+		path := writeTestConfigFile(t, "config.json", `{`)
+		c := newTestConfigFileCommand(&ConfigFile{Decoders: map[string]ConfigDecoder{
+			".json": func([]byte) (map[string]interface{}, error) { return nil, errTestDecode },
+		}})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path})
+		requirez.ErrorIs(t, err, errTestDecode)
+	})
+}
@@ -0,0 +1,213 @@
+package cliz
+
+import (
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// yamlLine is the line of YAML without the comment and the indentation.
+type yamlLine struct {
+	number  int
+	indent  int
+	content string
+}
+
+// decodeYAMLConfig decodes the subset of YAML which is enough for the config file:
+// block mappings, block sequences of scalars, flow sequences of scalars, plain and quoted scalars, and comments.
+// All the scalars are decoded as string, because they are parsed by the options.
+// The other syntax, like block scalars, anchors and flow mappings, is rejected with ErrUnsupportedConfigFormat.
+func decodeYAMLConfig(data []byte) (map[string]interface{}, error) {
+	lines := make([]yamlLine, 0)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(stripYAMLComment(line), " \t\r")
+		content := strings.TrimLeft(line, " ")
+		if content == "" || content == "---" {
+			continue
+		}
+		if strings.HasPrefix(content, "\t") {
+			return nil, errorz.Errorf("line %d: tab indentation: %w", i+1, ErrUnsupportedConfigFormat)
+		}
+		lines = append(lines, yamlLine{number: i + 1, indent: len(line) - len(content), content: content})
+	}
+
+	if len(lines) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	value, next, err := parseYAMLBlock(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if next < len(lines) {
+		return nil, errorz.Errorf("line %d: unexpected indentation: %w", lines[next].number, ErrUnsupportedConfigFormat)
+	}
+
+	config, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errorz.Errorf("line %d: top level must be a mapping: %w", lines[0].number, ErrUnsupportedConfigFormat)
+	}
+
+	return config, nil
+}
+
+func parseYAMLBlock(lines []yamlLine, i, indent int) (value interface{}, next int, err error) {
+	if isYAMLSequenceItem(lines[i].content) {
+		return parseYAMLSequence(lines, i, indent)
+	}
+	return parseYAMLMapping(lines, i, indent)
+}
+
+func parseYAMLMapping(lines []yamlLine, i, indent int) (value interface{}, next int, err error) {
+	m := make(map[string]interface{})
+	for i < len(lines) && lines[i].indent == indent {
+		line := lines[i]
+		if isYAMLSequenceItem(line.content) {
+			return nil, 0, errorz.Errorf("line %d: sequence item in mapping: %w", line.number, ErrUnsupportedConfigFormat)
+		}
+
+		key, rest, ok := splitYAMLKeyValue(line.content)
+		if !ok {
+			return nil, 0, errorz.Errorf("line %d: missing ':': %w", line.number, ErrUnsupportedConfigFormat)
+		}
+		key, err = unquoteYAMLScalar(key)
+		if err != nil {
+			return nil, 0, errorz.Errorf("line %d: %w", line.number, err)
+		}
+
+		i++
+		switch {
+		case rest != "":
+			v, err := parseYAMLInlineValue(rest)
+			if err != nil {
+				return nil, 0, errorz.Errorf("line %d: %w", line.number, err)
+			}
+			m[key] = v
+		case i < len(lines) && lines[i].indent > indent,
+			// NOTE: The block sequence may have the same indentation as the key.
+			i < len(lines) && lines[i].indent == indent && isYAMLSequenceItem(lines[i].content):
+			v, n, err := parseYAMLBlock(lines, i, lines[i].indent)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key], i = v, n
+		default:
+			m[key] = nil
+		}
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, 0, errorz.Errorf("line %d: unexpected indentation: %w", lines[i].number, ErrUnsupportedConfigFormat)
+	}
+
+	return m, i, nil
+}
+
+func parseYAMLSequence(lines []yamlLine, i, indent int) (value interface{}, next int, err error) {
+	s := make([]interface{}, 0)
+	for i < len(lines) && lines[i].indent == indent && isYAMLSequenceItem(lines[i].content) {
+		item := strings.TrimSpace(strings.TrimPrefix(lines[i].content, "-"))
+		if _, _, ok := splitYAMLKeyValue(item); ok || strings.HasPrefix(item, "[") {
+			return nil, 0, errorz.Errorf("line %d: only scalar sequence items are supported: %w", lines[i].number, ErrUnsupportedConfigFormat)
+		}
+		v, err := unquoteYAMLScalar(item)
+		if err != nil {
+			return nil, 0, errorz.Errorf("line %d: %w", lines[i].number, err)
+		}
+		s = append(s, v)
+		i++
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, 0, errorz.Errorf("line %d: unexpected indentation: %w", lines[i].number, ErrUnsupportedConfigFormat)
+	}
+
+	return s, i, nil
+}
+
+func parseYAMLInlineValue(s string) (interface{}, error) {
+	if !strings.HasPrefix(s, "[") {
+		if s == "~" || s == "null" {
+			return nil, nil //nolint:nilnil
+		}
+		return unquoteYAMLScalar(s)
+	}
+
+	if !strings.HasSuffix(s, "]") {
+		return nil, errorz.Errorf("%s: unterminated flow sequence: %w", s, ErrUnsupportedConfigFormat)
+	}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+	values := make([]interface{}, 0)
+	if inner == "" {
+		return values, nil
+	}
+	for _, item := range splitYAMLFlowItems(inner) {
+		v, err := unquoteYAMLScalar(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func isYAMLSequenceItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+// splitYAMLKeyValue splits `key: value` at the first ": " outside the quotes.
+func splitYAMLKeyValue(content string) (key, value string, ok bool) {
+	i := indexYAMLOutsideQuotes(content, func(i int) bool {
+		return content[i] == ':' && (i == len(content)-1 || content[i+1] == ' ')
+	})
+	if i < 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(content[:i]), strings.TrimSpace(content[i+1:]), true
+}
+
+// splitYAMLFlowItems splits the items of the flow sequence at the commas outside the quotes.
+func splitYAMLFlowItems(s string) []string {
+	items := make([]string, 0)
+	for {
+		i := indexYAMLOutsideQuotes(s, func(i int) bool { return s[i] == ',' })
+		if i < 0 {
+			return append(items, s)
+		}
+		items = append(items, s[:i])
+		s = s[i+1:]
+	}
+}
+
+// stripYAMLComment removes the comment which starts with "#" after a whitespace or at the beginning, outside the quotes.
+func stripYAMLComment(line string) string {
+	i := indexYAMLOutsideQuotes(line, func(i int) bool {
+		return line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t')
+	})
+	if i < 0 {
+		return line
+	}
+	return line[:i]
+}
+
+// indexYAMLOutsideQuotes returns the index of the first byte outside the quotes for which match returns true, or -1.
+// A quote starts only at the beginning of a token, so that the apostrophe in a plain scalar like `it's` is not a quote.
+func indexYAMLOutsideQuotes(s string, match func(i int) bool) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			// skip the escaped character
+			i++
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case (s[i] == '"' || s[i] == '\'') && (i == 0 || strings.IndexByte(" \t,[:", s[i-1]) >= 0):
+			quote = s[i]
+		case match(i):
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,100 @@
+package cliz
+
+import (
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func Test_decodeYAMLConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		data := `---
+# comment
+plain: it's a value # trailing comment
+double: "a \"b\" # c"
+escapes: "\e\N\_\L\P\/\x41\u00e9\U0001F600"
+single: 'it''s'
+empty:
+null-value: ~
+flow: [a, "b,c", 'd']
+empty-flow: []
+"quoted key": value
+block:
+- a
+- 'b'
+nested:
+  key: value
+  deep:
+    list:
+      - x
+`
+		actual, err := decodeYAMLConfig([]byte(data))
+		requirez.NoError(t, err)
+		expected := map[string]interface{}{
+			"plain":      "it's a value",
+			"double":     `a "b" # c`,
+			"escapes":    "\x1b\u0085\u00a0\u2028\u2029/A\u00e9\U0001F600",
+			"single":     "it's",
+			"empty":      nil,
+			"null-value": nil,
+			"flow":       []interface{}{"a", "b,c", "d"},
+			"empty-flow": []interface{}{},
+			"quoted key": "value",
+			"block":      []interface{}{"a", "b"},
+			"nested": map[string]interface{}{
+				"key":  "value",
+				"deep": map[string]interface{}{"list": []interface{}{"x"}},
+			},
+		}
+		assertz.Equal(t, expected, actual)
+	})
+
+	t.Run("success,empty", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := decodeYAMLConfig([]byte("# only comment\n"))
+		requirez.NoError(t, err)
+		assertz.Equal(t, map[string]interface{}{}, actual)
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			data string
+		}{
+			{name: "missing_colon", data: "key value\n"},
+			{name: "top_level_sequence", data: "- a\n- b\n"},
+			{name: "unexpected_indentation", data: "a: 1\n  b: 2\n"},
+			{name: "sequence_of_mappings", data: "a:\n  - b: 1\n"},
+			{name: "unterminated_flow", data: "a: [b, c\n"},
+			{name: "unterminated_quote", data: "a: 'b\n"},
+			{name: "invalid_double_quote", data: `a: "\q"` + "\n"},
+			{name: "invalid_hex_escape", data: `a: "\xZZ"` + "\n"},
+			{name: "multi_line_double_quote", data: "a: \"b\n  c\"\n"},
+			{name: "tab_indentation", data: "a:\n\tb: 1\n"},
+			{name: "literal_block_scalar", data: "a: |\n  b\n"},
+			{name: "folded_block_scalar", data: "a: >-\n  b\n"},
+			{name: "anchor", data: "a: &x b\n"},
+			{name: "alias", data: "a: *x\n"},
+			{name: "tag", data: "a: !!str b\n"},
+			{name: "flow_mapping", data: "a: {b: 1}\n"},
+			{name: "block_scalar_in_sequence", data: "a:\n  - |\n"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := decodeYAMLConfig([]byte(tt.data))
+				requirez.Error(t, err)
+			})
+		}
+	})
+}
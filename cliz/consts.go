@@ -4,4 +4,6 @@ const (
 	breakArg          = "--"
 	longOptionPrefix  = "--"
 	shortOptionPrefix = "-"
+	// negatedOptionPrefix is the prefix of the negated boolean option in POSIXStyle, like `--no-verbose`.
+	negatedOptionPrefix = "no-"
 )
@@ -10,20 +10,41 @@ import (
 
 //nolint:gochecknoglobals
 var (
-	DefaultCompletionSubCommandName             = "completion"
-	DefaultGenerateBashCompletionSubCommandName = "__generate_bash_completion"
-	DefaultGenerateZshCompletionSubCommandName  = "__generate_zsh_completion"
+	DefaultCompletionSubCommandName                   = "completion"
+	DefaultGenerateBashCompletionSubCommandName       = "__generate_bash_completion"
+	DefaultGenerateZshCompletionSubCommandName        = "__generate_zsh_completion"
+	DefaultGenerateFishCompletionSubCommandName       = "__generate_fish_completion"
+	DefaultGeneratePowerShellCompletionSubCommandName = "__generate_powershell_completion"
+	DefaultConfigFileOptionName                       = "config"
+	DefaultOutputOptionName                           = "output"
+	DefaultOutputFormat                               = OutputFormatTable
+	DefaultConfirmOptionName                          = "yes"
 
 	DefaultTagKey         = "cli"
+	DefaultArgTagKey      = "cliarg"
 	DefaultAliasKey       = "alias"
 	DefaultEnvKey         = "env"
 	DefaultDefaultKey     = "default"
 	DefaultRequiredKey    = "required"
 	DefaultDescriptionKey = "description"
 	DefaultHiddenKey      = "hidden"
+	DefaultSensitiveKey   = "sensitive"
+	DefaultEnumKey        = "enum"
+	DefaultLayoutKey      = "layout"
+	DefaultTableTagKey    = "table"
 
+	Stdin  io.Reader = os.Stdin
 	Stdout io.Writer = os.Stdout
 	Stderr io.Writer = os.Stderr
 
+	// Getenv looks up the environment variables of the option values, which can be replaced in the tests.
+	Getenv = os.Getenv
+
+	// DefaultPrompter is the Prompter used when Prompter of the command is nil.
+	DefaultPrompter = NewTerminalPrompter(os.Stdin, os.Stderr)
+
+	// SensitiveMaskFunc masks the values of the sensitive options in the help message, the errors and the effective config.
+	SensitiveMaskFunc = MaskSensitiveValue
+
 	Logger = slog.New(slogz.NewHandler(io.Discard, slog.LevelDebug))
 )
@@ -0,0 +1,135 @@
+package cliz
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Deprecated is the deprecation of the command, the option or the alias.
+//
+// The deprecated name still works, but the warning is printed to Stderr once when it is used,
+// and it is listed in the "Deprecated:" section of the help message instead of the completion.
+type Deprecated struct {
+	// Replacement is the name to use instead, like "new-cmd" or "--new-flag".
+	//
+	// If Replacement of the deprecated alias is empty, the name of the command or the option is used.
+	Replacement string
+	// Message is the additional message, like "will be removed in v2".
+	Message string
+}
+
+// describe returns the deprecation like `deprecated, use --new-flag instead: will be removed in v2`.
+func (d *Deprecated) describe(defaultReplacement string) string {
+	s := "deprecated"
+	if replacement := d.Replacement; replacement != "" || defaultReplacement != "" {
+		if replacement == "" {
+			replacement = defaultReplacement
+		}
+		s += ", use " + replacement + " instead"
+	}
+	if d.Message != "" {
+		s += ": " + d.Message
+	}
+	return s
+}
+
+// getOptionAliases returns the aliases of the option, including the deprecated ones.
+func getOptionAliases(o Option) []string {
+	return slices.Concat(o.GetAliases(), slices.Sorted(maps.Keys(o.GetDeprecatedAliases())))
+}
+
+// getRoot returns the root command.
+func (c *Command) getRoot() *Command {
+	root := c
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// warnDeprecated prints the warning to Stderr, only once for each deprecated name in the command tree.
+func (c *Command) warnDeprecated(kind, name string, d *Deprecated, defaultReplacement string) {
+	root := c.getRoot()
+	key := kind + " " + name
+	if root.deprecationWarned[key] {
+		return
+	}
+	if root.deprecationWarned == nil {
+		root.deprecationWarned = make(map[string]bool)
+	}
+	root.deprecationWarned[key] = true
+
+	_, _ = fmt.Fprintf(c.Stderr(), "warning: %s %s is %s\n", kind, name, d.describe(defaultReplacement))
+}
+
+// warnDeprecatedSubcommand warns if the subcommand or its alias used as arg is deprecated.
+func (c *Command) warnDeprecatedSubcommand(subcmd *Command, arg string) {
+	if subcmd.Deprecated != nil {
+		c.warnDeprecated("command", subcmd.Name, subcmd.Deprecated, "")
+		return
+	}
+	if d, ok := subcmd.DeprecatedAliases[arg]; ok && d != nil {
+		c.warnDeprecated("command", arg, d, subcmd.Name)
+	}
+}
+
+// warnDeprecatedOption warns if the option or its alias used as name is deprecated.
+func (c *Command) warnDeprecatedOption(o Option, name string) {
+	if d := o.GetDeprecated(); d != nil {
+		c.warnDeprecated("option", longOptionPrefix+o.GetName(), d, "")
+		return
+	}
+	if d, ok := o.GetDeprecatedAliases()[name]; ok && d != nil {
+		c.warnDeprecated("option", optionAliasString(name), d, longOptionPrefix+o.GetName())
+	}
+}
+
+// optionNameOfArg returns the option name in osArg, like "foo" of `--foo=bar` or `--no-foo`.
+func optionNameOfArg(o Option, osArg string) string {
+	name, _, _ := strings.Cut(strings.TrimLeft(osArg, shortOptionPrefix), "=")
+	if negated, ok := strings.CutPrefix(name, negatedOptionPrefix); ok && name != o.GetName() && !slices.Contains(getOptionAliases(o), name) {
+		return negated
+	}
+	return name
+}
+
+func optionAliasString(alias string) string {
+	return shortOptionPrefix + alias
+}
+
+// deprecatedHelpEntries returns the deprecated subcommands, options and aliases in the help message.
+func (c *Command) deprecatedHelpEntries() []*HelpEntry {
+	entries := make([]*HelpEntry, 0)
+
+	for _, subcmd := range c.SubCommands {
+		if subcmd.Hidden {
+			continue
+		}
+		if subcmd.Deprecated != nil {
+			entries = append(entries, &HelpEntry{Name: subcmd.Name, Description: subcmd.Deprecated.describe("")})
+		}
+		for _, alias := range slices.Sorted(maps.Keys(subcmd.DeprecatedAliases)) {
+			if d := subcmd.DeprecatedAliases[alias]; d != nil {
+				entries = append(entries, &HelpEntry{Name: alias, Description: d.describe(subcmd.Name)})
+			}
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if opt.IsHidden() {
+			continue
+		}
+		if d := opt.GetDeprecated(); d != nil {
+			entries = append(entries, &HelpEntry{Name: longOptionPrefix + opt.GetName(), Description: d.describe("")})
+		}
+		for _, alias := range slices.Sorted(maps.Keys(opt.GetDeprecatedAliases())) {
+			if d := opt.GetDeprecatedAliases()[alias]; d != nil {
+				entries = append(entries, &HelpEntry{Name: optionAliasString(alias), Description: d.describe(longOptionPrefix + opt.GetName())})
+			}
+		}
+	}
+
+	return entries
+}
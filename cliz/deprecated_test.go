@@ -0,0 +1,122 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestDeprecatedCommand() *Command {
+	return &Command{
+		Name:       "main-cli",
+		POSIXStyle: true,
+		Options: []Option{
+			&StringOption{Name: "new-flag", DeprecatedAliases: map[string]*Deprecated{"old-flag": {Message: "will be removed in v2"}, "o": {}}},
+			&BoolOption{Name: "legacy", Deprecated: &Deprecated{Replacement: "--new-flag"}},
+		},
+		SubCommands: []*Command{
+			{
+				Name:              "new-cmd",
+				Description:       "run new-cmd",
+				DeprecatedAliases: map[string]*Deprecated{"old-cmd": {}},
+				ExecFunc:          func(*Command, []string) error { return nil },
+			},
+			{
+				Name:        "legacy-cmd",
+				Description: "run legacy-cmd",
+				Deprecated:  &Deprecated{Replacement: "new-cmd", Message: "will be removed in v2"},
+				ExecFunc:    func(*Command, []string) error { return nil },
+			},
+		},
+	}
+}
+
+func TestCommand_Deprecated(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			osArgs  []string
+			value   string
+			warning string
+		}{
+			{name: "not_deprecated", osArgs: []string{"main-cli", "--new-flag=v", "new-cmd"}, value: "v", warning: ""},
+			{name: "deprecated_option_alias", osArgs: []string{"main-cli", "--old-flag", "v", "new-cmd"}, value: "v", warning: "warning: option -old-flag is deprecated, use --new-flag instead: will be removed in v2\n"},
+			{name: "deprecated_option_alias_equal", osArgs: []string{"main-cli", "-o=v", "new-cmd"}, value: "v", warning: "warning: option -o is deprecated, use --new-flag instead\n"},
+			{name: "deprecated_option_alias_cluster", osArgs: []string{"main-cli", "-ov", "new-cmd"}, value: "v", warning: "warning: option -o is deprecated, use --new-flag instead\n"},
+			{name: "deprecated_option", osArgs: []string{"main-cli", "--no-legacy", "new-cmd"}, value: "", warning: "warning: option --legacy is deprecated, use --new-flag instead\n"},
+			{name: "deprecated_command_alias", osArgs: []string{"main-cli", "old-cmd"}, value: "", warning: "warning: command old-cmd is deprecated, use new-cmd instead\n"},
+			{name: "deprecated_command", osArgs: []string{"main-cli", "legacy-cmd"}, value: "", warning: "warning: command legacy-cmd is deprecated, use new-cmd instead: will be removed in v2\n"},
+			{name: "warn_once", osArgs: []string{"main-cli", "--old-flag=a", "--old-flag=b", "new-cmd"}, value: "b", warning: "warning: option -old-flag is deprecated, use --new-flag instead: will be removed in v2\n"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestDeprecatedCommand()
+				buf := bytes.NewBuffer(nil)
+				c.SetStderrRecursive(buf)
+
+				requirez.NoError(t, c.Exec(context.Background(), tt.osArgs))
+
+				value, err := c.GetOptionString("new-flag")
+				requirez.NoError(t, err)
+				assertz.Equal(t, tt.value, value)
+				assertz.Equal(t, tt.warning, buf.String())
+			})
+		}
+	})
+
+	t.Run("success,help", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestDeprecatedCommand()
+		buf := bytes.NewBuffer(nil)
+		c.SetStderrRecursive(buf)
+
+		err := c.Exec(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+
+		const expected = `Usage:
+    main-cli [options] <subcommand>
+
+Sub Commands:
+    new-cmd    run new-cmd
+
+Options:
+    --new-flag (default: )
+        string value of new-flag
+    --help (default: false)
+        show help message and exit
+
+Deprecated:
+    old-cmd       deprecated, use new-cmd instead
+    legacy-cmd    deprecated, use new-cmd instead: will be removed in v2
+    -o            deprecated, use --new-flag instead
+    -old-flag     deprecated, use --new-flag instead: will be removed in v2
+    --legacy      deprecated, use --new-flag instead
+`
+		assertz.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,completion", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestDeprecatedCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+
+		values := make([]string, 0)
+		for _, candidate := range c.getCompletionCandidates() {
+			values = append(values, candidate.value)
+		}
+		assertz.Equal(t, []string{"new-cmd", "--new-flag", "--help"}, values)
+	})
+}
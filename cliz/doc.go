@@ -1,2 +1,2 @@
-// cliz package provides a framework for building command-line interfaces in Go, featuring command hierarchy, option parsing, bash/zsh completion support, and standardized CLI structure management.
+// cliz package provides a framework for building command-line interfaces in Go, featuring command hierarchy, option parsing, bash/zsh/fish/PowerShell completion support, and standardized CLI structure management.
 package cliz
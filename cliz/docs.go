@@ -0,0 +1,179 @@
+package cliz
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type generateDocsConfig struct {
+	manSection string
+	date       time.Time
+}
+
+type GenerateDocsOption interface {
+	apply(c *generateDocsConfig)
+}
+
+type withGenerateDocsOptionManSection struct {
+	manSection string
+}
+
+func (w *withGenerateDocsOptionManSection) apply(c *generateDocsConfig) {
+	c.manSection = w.manSection
+}
+
+// WithGenerateDocsOptionManSection sets the section of the man pages. The default is "1".
+func WithGenerateDocsOptionManSection(section string) GenerateDocsOption {
+	return &withGenerateDocsOptionManSection{manSection: section}
+}
+
+type withGenerateDocsOptionDate struct {
+	date time.Time
+}
+
+func (w *withGenerateDocsOptionDate) apply(c *generateDocsConfig) {
+	c.date = w.date
+}
+
+// WithGenerateDocsOptionDate sets the date of the man pages. The date is omitted by default, so that the output is reproducible.
+func WithGenerateDocsOptionDate(date time.Time) GenerateDocsOption {
+	return &withGenerateDocsOptionDate{date: date}
+}
+
+func newGenerateDocsConfig(opts []GenerateDocsOption) *generateDocsConfig {
+	cfg := &generateDocsConfig{
+		manSection: "1",
+		date:       time.Time{},
+	}
+
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+
+	return cfg
+}
+
+// docCommand is the command in the command tree to generate the document.
+type docCommand struct {
+	*Command
+
+	// names is the command names from the root command.
+	names []string
+	// parent is the parent command, or nil for the root command.
+	parent *docCommand
+	// children is the visible subcommands.
+	children []*docCommand
+}
+
+// newDocCommand walks the command tree, skipping the hidden commands.
+func newDocCommand(c *Command, parent *docCommand) *docCommand {
+	dc := &docCommand{
+		Command:  c,
+		names:    []string{c.Name},
+		parent:   parent,
+		children: make([]*docCommand, 0),
+	}
+	if parent != nil {
+		dc.names = append(append(make([]string, 0, len(parent.names)+1), parent.names...), c.Name)
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if subcmd.Hidden {
+			continue
+		}
+		dc.children = append(dc.children, newDocCommand(subcmd, dc))
+	}
+
+	return dc
+}
+
+func (dc *docCommand) walk(fn func(dc *docCommand) error) error {
+	if err := fn(dc); err != nil {
+		return err
+	}
+	for _, child := range dc.children {
+		if err := child.walk(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visibleOptions returns the options that are not hidden, except PersistentOptions.
+func (dc *docCommand) visibleOptions() []Option {
+	options := make([]Option, 0, len(dc.Options))
+	for _, opt := range dc.Options {
+		// NOTE: PersistentOptions are appended to Options by parse, and they are in visibleGlobalOptions.
+		if opt.IsHidden() || dc.isPersistentOption(opt) {
+			continue
+		}
+		options = append(options, opt)
+	}
+	return options
+}
+
+// visibleGlobalOptions returns PersistentOptions of the command and the ancestor commands that are not hidden,
+// in the same order as getGlobalOptions. It walks the parents of docCommand, so that the command tree is not modified.
+func (dc *docCommand) visibleGlobalOptions() []Option {
+	options := make([]Option, 0)
+	for p := dc; p != nil; p = p.parent {
+		for _, opt := range p.PersistentOptions {
+			if opt.IsHidden() {
+				continue
+			}
+			options = append(options, opt)
+		}
+	}
+	return options
+}
+
+// optionNames returns the option names like `--foo, -f`.
+func optionNames(opt Option) []string {
+	names := make([]string, 0, 1+len(opt.GetAliases()))
+	if name := opt.GetName(); name != "" {
+		names = append(names, longOptionPrefix+name)
+	}
+	for _, alias := range opt.GetAliases() {
+		names = append(names, shortOptionPrefix+alias)
+	}
+	return names
+}
+
+// optionAttributes returns the attributes of the option displayed in the document, like `required`, `default: x`.
+func optionAttributes(opt Option) []string {
+	attrs := make([]string, 0)
+	if opt.IsRequired() {
+		attrs = append(attrs, "required")
+	}
+	if env := opt.GetEnv(); env != "" {
+		attrs = append(attrs, "env: "+env)
+	}
+	attrs = append(attrs, fmt.Sprintf("default: %v", maskOptionValue(opt, opt.GetDefault())))
+	switch o := opt.(type) {
+	case *EnumOption:
+		attrs = append(attrs, "allowed: "+strings.Join(o.Allowed, enumAllowedSeparator))
+	case *TimeOption:
+		attrs = append(attrs, "layout: "+o.GetLayout())
+	}
+	return attrs
+}
+
+func writeDocFiles(c *Command, dir string, filename func(dc *docCommand) string, write func(dc *docCommand) string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec,mnd
+		return errorz.Errorf("os.MkdirAll: %w", err)
+	}
+
+	//nolint:wrapcheck
+	return newDocCommand(c, nil).walk(func(dc *docCommand) error {
+		path := filepath.Join(dir, filename(dc))
+		if err := os.WriteFile(path, []byte(write(dc)), 0o644); err != nil { //nolint:gosec,mnd
+			return errorz.Errorf("os.WriteFile: %w", err)
+		}
+		return nil
+	})
+}
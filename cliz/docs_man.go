@@ -0,0 +1,150 @@
+package cliz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenerateManPages writes the roff man pages of the command and its visible subcommands to dir, one file per command,
+// like `main-cli.1` and `main-cli-sub-cmd.1`.
+func (c *Command) GenerateManPages(dir string, opts ...GenerateDocsOption) error {
+	cfg := newGenerateDocsConfig(opts)
+
+	return writeDocFiles(c, dir,
+		func(dc *docCommand) string { return manPageName(dc) + "." + cfg.manSection },
+		func(dc *docCommand) string { return manPage(dc, cfg) },
+	)
+}
+
+func manPageName(dc *docCommand) string {
+	return strings.Join(dc.names, "-")
+}
+
+//nolint:cyclop,funlen
+func manPage(dc *docCommand, cfg *generateDocsConfig) string {
+	b := new(strings.Builder)
+
+	date := ""
+	if !cfg.date.IsZero() {
+		date = cfg.date.Format("2006-01-02")
+	}
+	fmt.Fprintf(b, ".TH %q %q %q %q %q\n", strings.ToUpper(manPageName(dc)), cfg.manSection, date, "", "")
+
+	b.WriteString(".SH NAME\n")
+	b.WriteString(roffEscape(manPageName(dc)))
+	if description, _, _ := strings.Cut(dc.Description, "\n"); description != "" {
+		b.WriteString(` \- ` + roffEscape(description))
+	}
+	b.WriteString("\n")
+
+	b.WriteString(".SH SYNOPSIS\n")
+	usage := dc.Usage
+	if usage == "" {
+		usage = dc.usageLine(dc.names)
+	}
+	b.WriteString(`\fB` + roffEscape(usage) + `\fR` + "\n")
+
+	if dc.Description != "" {
+		b.WriteString(".SH DESCRIPTION\n")
+		b.WriteString(roffEscapeText(dc.Description) + "\n")
+	}
+
+	if options := dc.visibleOptions(); len(options) > 0 {
+		b.WriteString(".SH OPTIONS\n")
+		writeManOptions(b, options)
+	}
+
+	if options := dc.visibleGlobalOptions(); len(options) > 0 {
+		b.WriteString(".SH GLOBAL OPTIONS\n")
+		writeManOptions(b, options)
+	}
+
+	if len(dc.Args) > 0 {
+		b.WriteString(".SH ARGUMENTS\n")
+		for _, arg := range dc.Args {
+			b.WriteString(".TP\n")
+			b.WriteString(`\fB` + roffEscape(arg.usage()) + `\fR` + "\n")
+			b.WriteString(roffEscapeText(arg.GetDescription()) + "\n")
+		}
+	}
+
+	if len(dc.ConstraintGroups) > 0 {
+		b.WriteString(".SH CONSTRAINTS\n")
+		for i, group := range dc.ConstraintGroups {
+			if i > 0 {
+				b.WriteString(".br\n")
+			}
+			b.WriteString(roffEscape(group.String()) + "\n")
+		}
+	}
+
+	if len(dc.children) > 0 {
+		b.WriteString(".SH COMMANDS\n")
+		for _, child := range dc.children {
+			b.WriteString(".TP\n")
+			b.WriteString(`\fB` + roffEscape(child.getNameAndAliasesString()) + `\fR`)
+			if child.Group != "" {
+				b.WriteString(" (" + roffEscape(child.Group) + ")")
+			}
+			b.WriteString("\n")
+			b.WriteString(roffEscapeText(child.Description) + "\n")
+		}
+	}
+
+	env := make([]Option, 0)
+	for _, opt := range append(dc.visibleOptions(), dc.visibleGlobalOptions()...) {
+		if opt.GetEnv() != "" {
+			env = append(env, opt)
+		}
+	}
+	if len(env) > 0 {
+		b.WriteString(".SH ENVIRONMENT\n")
+		for _, opt := range env {
+			b.WriteString(".TP\n")
+			b.WriteString(`\fB` + roffEscape(opt.GetEnv()) + `\fR` + "\n")
+			b.WriteString("Sets " + roffEscape(longOptionPrefix+opt.GetName()) + ".\n")
+		}
+	}
+
+	seeAlso := make([]string, 0)
+	if dc.parent != nil {
+		seeAlso = append(seeAlso, `\fB`+roffEscape(manPageName(dc.parent))+`\fR(`+cfg.manSection+`)`)
+	}
+	for _, child := range dc.children {
+		seeAlso = append(seeAlso, `\fB`+roffEscape(manPageName(child))+`\fR(`+cfg.manSection+`)`)
+	}
+	if len(seeAlso) > 0 {
+		b.WriteString(".SH SEE ALSO\n")
+		b.WriteString(strings.Join(seeAlso, ", ") + "\n")
+	}
+
+	return b.String()
+}
+
+func writeManOptions(b *strings.Builder, options []Option) {
+	for _, opt := range options {
+		b.WriteString(".TP\n")
+		names := make([]string, 0)
+		for _, name := range optionNames(opt) {
+			names = append(names, `\fB`+roffEscape(name)+`\fR`)
+		}
+		b.WriteString(strings.Join(names, ", ") + " (" + roffEscape(strings.Join(optionAttributes(opt), ", ")) + ")\n")
+		b.WriteString(roffEscapeText(opt.GetDescription()) + "\n")
+	}
+}
+
+// roffEscape escapes the backslashes and the hyphens in s for roff.
+func roffEscape(s string) string {
+	return strings.NewReplacer(`\`, `\e`, `-`, `\-`).Replace(s)
+}
+
+// roffEscapeText escapes the multi-line text for roff, so that a line starting with "." or "'" is not interpreted as a request.
+func roffEscapeText(s string) string {
+	lines := strings.Split(roffEscape(s), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[i] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
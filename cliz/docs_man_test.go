@@ -0,0 +1,117 @@
+package cliz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_GenerateManPages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		err := newTestDocsCommand().GenerateManPages(dir, WithGenerateDocsOptionManSection("8"), WithGenerateDocsOptionDate(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+		requirez.NoError(t, err)
+
+		entries, err := os.ReadDir(dir)
+		requirez.NoError(t, err)
+		names := make([]string, 0)
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		assertz.Equal(t, []string{"main-cli-sub-cmd.8", "main-cli.8"}, names)
+
+		const expectedRoot = `.TH "MAIN-CLI" "8" "2024-01-02" "" ""
+.SH NAME
+main\-cli \- my main command
+.SH SYNOPSIS
+\fBmain\-cli [options] <subcommand>\fR
+.SH DESCRIPTION
+my main command
+\&.with a line starting with dot
+.SH OPTIONS
+.TP
+\fB\-\-output\fR, \fB\-o\fR (env: OUTPUT, default: out|put)
+output\efile
+.TP
+\fB\-\-format\fR (required, default: json, allowed: json|yaml)
+enum value of format
+.SH COMMANDS
+.TP
+\fBsub\-cmd, sub\fR (group1)
+my sub command
+.SH ENVIRONMENT
+.TP
+\fBOUTPUT\fR
+Sets \-\-output.
+.SH SEE ALSO
+\fBmain\-cli\-sub\-cmd\fR(8)
+`
+		assertz.Equal(t, expectedRoot, string(discard(os.ReadFile(filepath.Join(dir, "main-cli.8")))))
+
+		const expectedSub = `.TH "MAIN-CLI-SUB-CMD" "8" "2024-01-02" "" ""
+.SH NAME
+main\-cli\-sub\-cmd \- my sub command
+.SH SYNOPSIS
+\fBmain\-cli sub\-cmd [options] <file>\fR
+.SH DESCRIPTION
+my sub command
+.SH OPTIONS
+.TP
+\fB\-\-a\fR (default: false)
+bool value of a
+.TP
+\fB\-\-b\fR (default: false)
+bool value of b
+.SH ARGUMENTS
+.TP
+\fB<file>\fR
+input file
+.SH CONSTRAINTS
+mutually exclusive: \-\-a, \-\-b
+.SH SEE ALSO
+\fBmain\-cli\fR(8)
+`
+		assertz.Equal(t, expectedSub, string(discard(os.ReadFile(filepath.Join(dir, "main-cli-sub-cmd.8")))))
+	})
+	t.Run("success,global_options", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		c := newTestDocsGlobalOptionsCommand()
+		requirez.NoError(t, c.GenerateManPages(dir, WithGenerateDocsOptionDate(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))))
+		// NOTE: The command tree is not modified by the document generation.
+		assertz.Nil(t, c.SubCommands[0].parent)
+
+		const expectedSub = `.TH "MAIN-CLI-SUB-CMD" "1" "2024-01-02" "" ""
+.SH NAME
+main\-cli\-sub\-cmd \- my sub command
+.SH SYNOPSIS
+\fBmain\-cli sub\-cmd [options]\fR
+.SH DESCRIPTION
+my sub command
+.SH OPTIONS
+.TP
+\fB\-\-a\fR (default: false)
+bool value of a
+.SH GLOBAL OPTIONS
+.TP
+\fB\-\-verbose\fR (env: VERBOSE, default: false)
+verbose output
+.SH ENVIRONMENT
+.TP
+\fBVERBOSE\fR
+Sets \-\-verbose.
+.SH SEE ALSO
+\fBmain\-cli\fR(1)
+`
+		assertz.Equal(t, expectedSub, string(discard(os.ReadFile(filepath.Join(dir, "main-cli-sub-cmd.1")))))
+	})
+}
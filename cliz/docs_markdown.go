@@ -0,0 +1,130 @@
+package cliz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenerateMarkdownDocs writes the Markdown reference of the command and its visible subcommands to dir, one file per command,
+// like `main-cli.md` and `main-cli_sub-cmd.md`. The files link to each other, so `main-cli.md` is the index of the reference.
+func (c *Command) GenerateMarkdownDocs(dir string) error {
+	return writeDocFiles(c, dir, markdownFileName, markdownDoc)
+}
+
+func markdownFileName(dc *docCommand) string {
+	return strings.Join(dc.names, "_") + ".md"
+}
+
+//nolint:cyclop,funlen
+func markdownDoc(dc *docCommand) string {
+	b := new(strings.Builder)
+
+	b.WriteString("# " + strings.Join(dc.names, " ") + "\n")
+
+	if dc.Description != "" {
+		b.WriteString("\n" + dc.Description + "\n")
+	}
+
+	b.WriteString("\n## Usage\n\n")
+	usage := dc.Usage
+	if usage == "" {
+		usage = dc.usageLine(dc.names)
+	}
+	b.WriteString("```\n" + usage + "\n```\n")
+
+	if len(dc.Aliases) > 0 {
+		b.WriteString("\nAliases: " + markdownCode(strings.Join(dc.Aliases, ", ")) + "\n")
+	}
+
+	if options := dc.visibleOptions(); len(options) > 0 {
+		b.WriteString("\n## Options\n\n")
+		writeMarkdownOptions(b, options)
+	}
+
+	if options := dc.visibleGlobalOptions(); len(options) > 0 {
+		b.WriteString("\n## Global Options\n\n")
+		writeMarkdownOptions(b, options)
+	}
+
+	if len(dc.Args) > 0 {
+		b.WriteString("\n## Arguments\n\n")
+		for _, arg := range dc.Args {
+			b.WriteString("- " + markdownCode(arg.usage()) + " (" + arg.Type.String() + "): " + arg.GetDescription() + "\n")
+		}
+	}
+
+	if len(dc.ConstraintGroups) > 0 {
+		b.WriteString("\n## Constraints\n\n")
+		for _, group := range dc.ConstraintGroups {
+			b.WriteString("- " + markdownCode(group.String()) + "\n")
+		}
+	}
+
+	if len(dc.children) > 0 {
+		b.WriteString("\n## Sub Commands\n")
+		groups := dc.getGroups()
+		for _, group := range groups {
+			if group != "" {
+				b.WriteString("\n### " + group + "\n")
+			}
+			b.WriteString("\n")
+			for _, child := range dc.children {
+				if child.Group != group {
+					continue
+				}
+				b.WriteString("- [" + strings.Join(child.names, " ") + "](" + markdownFileName(child) + ")")
+				if child.Description != "" {
+					description, _, _ := strings.Cut(child.Description, "\n")
+					b.WriteString(" - " + description)
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	if dc.parent != nil {
+		b.WriteString("\n## See Also\n\n")
+		b.WriteString("- [" + strings.Join(dc.parent.names, " ") + "](" + markdownFileName(dc.parent) + ")\n")
+	}
+
+	return b.String()
+}
+
+func writeMarkdownOptions(b *strings.Builder, options []Option) {
+	b.WriteString("| Option | Env | Default | Required | Description |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, opt := range options {
+		required := ""
+		if opt.IsRequired() {
+			required = "yes"
+		}
+		env := ""
+		if opt.GetEnv() != "" {
+			env = markdownCode(opt.GetEnv())
+		}
+		description := opt.GetDescription()
+		switch o := opt.(type) {
+		case *EnumOption:
+			description += " (allowed: " + strings.Join(o.Allowed, enumAllowedSeparator) + ")"
+		case *TimeOption:
+			description += " (layout: " + o.GetLayout() + ")"
+		}
+		b.WriteString("| " + markdownTableCell(markdownCode(strings.Join(optionNames(opt), ", "))) +
+			" | " + env +
+			" | " + markdownTableCell(markdownCode(fmt.Sprintf("%v", maskOptionValue(opt, opt.GetDefault())))) +
+			" | " + required +
+			" | " + markdownTableCell(description) + " |\n")
+	}
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
+
+// markdownTableCell escapes the pipes and the newlines which break the table.
+func markdownTableCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", "<br>").Replace(s)
+}
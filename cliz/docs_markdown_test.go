@@ -0,0 +1,132 @@
+package cliz
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_GenerateMarkdownDocs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		requirez.NoError(t, newTestDocsCommand().GenerateMarkdownDocs(dir))
+
+		const expectedRoot = "# main-cli\n" +
+			"\n" +
+			"my main command\n" +
+			".with a line starting with dot\n" +
+			"\n" +
+			"## Usage\n" +
+			"\n" +
+			"```\n" +
+			"main-cli [options] <subcommand>\n" +
+			"```\n" +
+			"\n" +
+			"## Options\n" +
+			"\n" +
+			"| Option | Env | Default | Required | Description |\n" +
+			"| --- | --- | --- | --- | --- |\n" +
+			"| `--output, -o` | `OUTPUT` | `out\\|put` |  | output\\file |\n" +
+			"| `--format` |  | `json` | yes | enum value of format (allowed: json\\|yaml) |\n" +
+			"\n" +
+			"## Sub Commands\n" +
+			"\n" +
+			"### group1\n" +
+			"\n" +
+			"- [main-cli sub-cmd](main-cli_sub-cmd.md) - my sub command\n"
+		assertz.Equal(t, expectedRoot, string(discard(os.ReadFile(filepath.Join(dir, "main-cli.md")))))
+
+		const expectedSub = "# main-cli sub-cmd\n" +
+			"\n" +
+			"my sub command\n" +
+			"\n" +
+			"## Usage\n" +
+			"\n" +
+			"```\n" +
+			"main-cli sub-cmd [options] <file>\n" +
+			"```\n" +
+			"\n" +
+			"Aliases: `sub`\n" +
+			"\n" +
+			"## Options\n" +
+			"\n" +
+			"| Option | Env | Default | Required | Description |\n" +
+			"| --- | --- | --- | --- | --- |\n" +
+			"| `--a` |  | `false` |  | bool value of a |\n" +
+			"| `--b` |  | `false` |  | bool value of b |\n" +
+			"\n" +
+			"## Arguments\n" +
+			"\n" +
+			"- `<file>` (string): input file\n" +
+			"\n" +
+			"## Constraints\n" +
+			"\n" +
+			"- `mutually exclusive: --a, --b`\n" +
+			"\n" +
+			"## See Also\n" +
+			"\n" +
+			"- [main-cli](main-cli.md)\n"
+		assertz.Equal(t, expectedSub, string(discard(os.ReadFile(filepath.Join(dir, "main-cli_sub-cmd.md")))))
+
+		_, err := os.Stat(filepath.Join(dir, "main-cli_hidden-cmd.md"))
+		requirez.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("success,global_options", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		c := newTestDocsGlobalOptionsCommand()
+		requirez.NoError(t, c.GenerateMarkdownDocs(dir))
+		// NOTE: The command tree is not modified by the document generation.
+		assertz.Nil(t, c.SubCommands[0].parent)
+
+		const expectedSub = "# main-cli sub-cmd\n" +
+			"\n" +
+			"my sub command\n" +
+			"\n" +
+			"## Usage\n" +
+			"\n" +
+			"```\n" +
+			"main-cli sub-cmd [options]\n" +
+			"```\n" +
+			"\n" +
+			"## Options\n" +
+			"\n" +
+			"| Option | Env | Default | Required | Description |\n" +
+			"| --- | --- | --- | --- | --- |\n" +
+			"| `--a` |  | `false` |  | bool value of a |\n" +
+			"\n" +
+			"## Global Options\n" +
+			"\n" +
+			"| Option | Env | Default | Required | Description |\n" +
+			"| --- | --- | --- | --- | --- |\n" +
+			"| `--verbose` | `VERBOSE` | `false` |  | verbose output |\n" +
+			"\n" +
+			"## See Also\n" +
+			"\n" +
+			"- [main-cli](main-cli.md)\n"
+		assertz.Equal(t, expectedSub, string(discard(os.ReadFile(filepath.Join(dir, "main-cli_sub-cmd.md")))))
+	})
+	t.Run("success,sensitive", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name:    "main-cli",
+			Options: []Option{&StringOption{Name: "token", Default: "s3cret", Sensitive: true, Description: "API token"}},
+		}
+		dir := t.TempDir()
+		requirez.NoError(t, c.GenerateMarkdownDocs(dir))
+
+		actual := string(discard(os.ReadFile(filepath.Join(dir, "main-cli.md"))))
+		assertz.StringContains(t, actual, "| `--token` |  | `******` |  | API token |\n")
+		assertz.False(t, strings.Contains(actual, "s3cret"))
+	})
+}
@@ -0,0 +1,75 @@
+package cliz
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestDocsCommand() *Command {
+	return &Command{
+		Name:        "main-cli",
+		Description: "my main command\n.with a line starting with dot",
+		Options: []Option{
+			&StringOption{Name: "output", Aliases: []string{"o"}, Env: "OUTPUT", Default: "out|put", Description: "output\\file"},
+			&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}, Default: "json", Required: true},
+			&StringOption{Name: "secret", Hidden: true},
+		},
+		SubCommands: []*Command{
+			{
+				Name:        "sub-cmd",
+				Aliases:     []string{"sub"},
+				Group:       "group1",
+				Description: "my sub command",
+				Args:        []*Arg{{Name: "file", Required: true, Description: "input file"}},
+				Options: []Option{
+					&BoolOption{Name: "a"},
+					&BoolOption{Name: "b"},
+				},
+				ConstraintGroups: []*ConstraintGroup{{Type: ConstraintMutuallyExclusive, Options: []string{"a", "b"}}},
+			},
+			{
+				Name:   "hidden-cmd",
+				Hidden: true,
+			},
+		},
+	}
+}
+
+func newTestDocsGlobalOptionsCommand() *Command {
+	return &Command{
+		Name:              "main-cli",
+		Description:       "my main command",
+		PersistentOptions: []Option{&BoolOption{Name: "verbose", Env: "VERBOSE", Description: "verbose output"}},
+		SubCommands: []*Command{
+			{
+				Name:        "sub-cmd",
+				Description: "my sub command",
+				Options:     []Option{&BoolOption{Name: "a"}},
+			},
+		},
+	}
+}
+
+func TestCommand_GenerateDocs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,os.MkdirAll", func(t *testing.T) {
+		t.Parallel()
+
+		file := filepath.Join(t.TempDir(), "file")
+		requirez.NoError(t, os.WriteFile(file, nil, 0o600))
+		requirez.ErrorContains(t, newTestDocsCommand().GenerateManPages(filepath.Join(file, "dir")), "os.MkdirAll: ")
+		requirez.ErrorContains(t, newTestDocsCommand().GenerateMarkdownDocs(filepath.Join(file, "dir")), "os.MkdirAll: ")
+	})
+
+	t.Run("error,os.WriteFile", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		requirez.NoError(t, os.Mkdir(filepath.Join(dir, "main-cli.md"), 0o700))
+		requirez.ErrorContains(t, newTestDocsCommand().GenerateMarkdownDocs(dir), "os.WriteFile: ")
+	})
+}
@@ -1,13 +1,37 @@
 package cliz
 
 import (
-	"os"
-	"strconv"
-
 	"github.com/hakadoriya/z.go/errorz"
 )
 
-//nolint:gocognit,cyclop
+// Getenv returns the value of the environment variable, which is looked up by the function set by SetGetenv or the global Getenv.
+func (c *Command) Getenv(key string) string {
+	if c.getenv != nil {
+		return c.getenv(key)
+	}
+
+	return Getenv(key)
+}
+
+func (c *Command) SetGetenv(getenv func(key string) string) {
+	if c == nil {
+		return
+	}
+
+	c.getenv = getenv
+}
+
+func (c *Command) SetGetenvRecursive(getenv func(key string) string) {
+	if c == nil {
+		return
+	}
+
+	c.SetGetenv(getenv)
+	for _, subcmd := range c.SubCommands {
+		subcmd.SetGetenvRecursive(getenv)
+	}
+}
+
 func (c *Command) loadEnvironments() error {
 	for _, opt := range c.Options {
 		if opt.GetEnv() == "" {
@@ -15,48 +39,17 @@ func (c *Command) loadEnvironments() error {
 			continue
 		}
 
-		switch o := opt.(type) {
-		case *StringOption:
-			if s := os.Getenv(o.Env); s != "" {
-				o.value = &s
-			}
-		case *BoolOption:
-			if s := os.Getenv(o.Env); s != "" {
-				v, err := strconv.ParseBool(s)
-				if err != nil {
-					return errorz.Errorf("%s: %w", o.Env, err)
-				}
-				o.value = &v
-			}
-		case *Int64Option:
-			if s := os.Getenv(o.Env); s != "" {
-				const base, bitSize = 10, 64
-				v, err := strconv.ParseInt(s, base, bitSize)
-				if err != nil {
-					return errorz.Errorf("%s: %w", o.Env, err)
-				}
-				o.value = &v
-			}
-		case *Uint64Option:
-			if s := os.Getenv(o.Env); s != "" {
-				const base, bitSize = 10, 64
-				v, err := strconv.ParseUint(s, base, bitSize)
-				if err != nil {
-					return errorz.Errorf("%s: %w", o.Env, err)
-				}
-				o.value = &v
-			}
-		case *Float64Option:
-			if s := os.Getenv(o.Env); s != "" {
-				const bitSize = 64
-				v, err := strconv.ParseFloat(s, bitSize)
-				if err != nil {
-					return errorz.Errorf("%s: %w", o.Env, err)
-				}
-				o.value = &v
+		o, ok := opt.(optionValue)
+		if !ok {
+			return errorz.Errorf("%s: %w", opt.GetName(), ErrInvalidOptionType)
+		}
+
+		if s := c.Getenv(o.GetEnv()); s != "" {
+			resetOptionValue(o)
+			if err := o.setValue(s); err != nil {
+				return errorz.Errorf("%s: %w", o.GetEnv(), maskOptionError(o, s, err))
 			}
-		default:
-			return errorz.Errorf("%s: %w", o.GetName(), ErrInvalidOptionType)
+			c.setOptionSource(o, OptionSourceEnv)
 		}
 	}
 
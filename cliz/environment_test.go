@@ -3,6 +3,7 @@ package cliz
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hakadoriya/z.go/testingz/assertz"
 	"github.com/hakadoriya/z.go/testingz/requirez"
@@ -92,4 +93,83 @@ func TestCommand_loadEnvironments(t *testing.T) {
 		_, err := c.parse(context.Background(), []string{"main-cli"})
 		requirez.ErrorContains(t, err, `strconv.ParseFloat: parsing "FAILURE": invalid syntax`)
 	})
+	t.Run("success,SliceOption", func(t *testing.T) {
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&StringSliceOption{Name: "tag", Env: "TAG", Default: []string{"default"}},
+				&Int64SliceOption{Name: "id", Env: "ID"},
+				&Uint64SliceOption{Name: "port", Env: "PORT"},
+				&Float64SliceOption{Name: "ratio", Env: "RATIO"},
+			},
+		}
+		t.Setenv("TAG", `a, b,"c,d"`)
+		t.Setenv("ID", "-1,2")
+		t.Setenv("PORT", "80,443")
+		t.Setenv("RATIO", "0.5,1.5")
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, []string{"a", "b", "c,d"}, discard(c.GetOptionStringSlice("tag")))
+		assertz.Equal(t, []int64{-1, 2}, discard(c.GetOptionInt64Slice("id")))
+		assertz.Equal(t, []uint64{80, 443}, discard(c.GetOptionUint64Slice("port")))
+		assertz.Equal(t, []float64{0.5, 1.5}, discard(c.GetOptionFloat64Slice("ratio")))
+	})
+
+	t.Run("success,SliceOption,args_take_precedence", func(t *testing.T) {
+		c := &Command{Name: "main-cli", Options: []Option{&StringSliceOption{Name: "tag", Env: "TAG"}}}
+		t.Setenv("TAG", "a,b")
+		_, err := c.parse(context.Background(), []string{"main-cli", "--tag", "c", "--tag", "d"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, []string{"c", "d"}, discard(c.GetOptionStringSlice("tag")))
+	})
+
+	t.Run("error,SliceOption", func(t *testing.T) {
+		c := &Command{Name: "main-cli", Options: []Option{&Uint64SliceOption{Name: "port", Env: "PORT"}}}
+		t.Setenv("PORT", "80,FAILURE")
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.ErrorContains(t, err, `PORT: strconv.ParseUint: parsing "FAILURE": invalid syntax`)
+	})
+	t.Run("success,TypedOption", func(t *testing.T) {
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&DurationOption{Name: "timeout", Env: "TIMEOUT"},
+				&EnumOption{Name: "format", Env: "FORMAT", Allowed: []string{"json", "yaml"}},
+			},
+		}
+		t.Setenv("TIMEOUT", "5s")
+		t.Setenv("FORMAT", "yaml")
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, 5*time.Second, discard(c.GetOptionDuration("timeout")))
+		assertz.Equal(t, "yaml", discard(c.GetOptionEnum("format")))
+	})
+
+	t.Run("error,TypedOption", func(t *testing.T) {
+		c := &Command{Name: "main-cli", Options: []Option{&EnumOption{Name: "format", Env: "FORMAT", Allowed: []string{"json", "yaml"}}}}
+		t.Setenv("FORMAT", "xml")
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		requirez.ErrorContains(t, err, `FORMAT: "xml": must be one of json|yaml`)
+	})
+}
+
+func TestCommand_Getenv(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,SetGetenvRecursive", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		c.SetGetenvRecursive(func(key string) string {
+			if key == "STRING_OPT" {
+				return "getenvValue"
+			}
+			return ""
+		})
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "getenvValue", discard(c.GetOptionString("string-opt")))
+		assertz.Equal(t, "getenvValue", c.SubCommands[0].Getenv("STRING_OPT"))
+	})
 }
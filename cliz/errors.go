@@ -10,9 +10,23 @@ var (
 	ErrDuplicateSubCommand         = errors.New("duplicate sub command")
 	ErrInvalidOptionType           = errors.New("invalid option type")
 	ErrMissingOptionValue          = errors.New("missing option value")
+	ErrInvalidOptionValue          = errors.New("invalid option value")
 	ErrNotCalled                   = errors.New("not called")
 	ErrOptionRequired              = errors.New("option required")
+	ErrNotConfirmed                = errors.New("not confirmed")
+	ErrMutuallyExclusiveOptions    = errors.New("mutually exclusive options")
+	ErrInvalidConstraint           = errors.New("invalid constraint")
 	ErrUnknownOption               = errors.New("unknown option")
+	ErrUnknownCommand              = errors.New("unknown command")
+	ErrMissingArgument             = errors.New("missing argument")
+	ErrTooManyArguments            = errors.New("too many arguments")
+	ErrInvalidArgument             = errors.New("invalid argument")
+	ErrUnknownArgument             = errors.New("unknown argument")
+	ErrInvalidArgSpec              = errors.New("invalid argument specification")
+	ErrUnknownConfigKey            = errors.New("unknown config key")
+	ErrUnsupportedConfigFormat     = errors.New("unsupported config format")
+	ErrUnsupportedOutputFormat     = errors.New("unsupported output format")
+	ErrUnsupportedOutputValue      = errors.New("unsupported output value")
 	ErrInvalidType                 = errors.New("invalid type; must be a pointer to a struct")
 	ErrStructFieldCannotBeSet      = errors.New("struct field cannot be set; unexported field or field is not settable")
 	ErrInvalidTagValue             = errors.New("invalid tag value")
@@ -17,7 +17,9 @@ func main() {
 		ExecFunc: func(c *cliz.Command, args []string) error {
 			const o = `This is a example command.
 Tips: Exec below commands for auto completion:
-	eval "$(./example completion bash)"
+	eval "$(./example completion bash)"  # bash
+	source <(./example completion zsh)    # zsh
+	./example completion fish | source    # fish
 `
 			_, _ = io.WriteString(c.Stdout(), o)
 			return nil
@@ -60,6 +62,9 @@ Tips: Exec below commands for auto completion:
 							&cliz.StringOption{
 								Name:        "who",
 								Description: "If you want to say 'Good morning, who!', set this option.",
+								CompleteFunc: func(_ *cliz.Command, _ string) []string {
+									return []string{"Alice", "Bob"}
+								},
 							},
 						},
 						ExecFunc: func(c *cliz.Command, _ []string) error {
@@ -0,0 +1,91 @@
+package cliz
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ExitCodeOK is the exit code for the success and the help.
+	ExitCodeOK = 0
+	// ExitCodeError is the exit code for the general error.
+	ExitCodeError = 1
+	// ExitCodeUsage is the exit code for the usage error, like the unknown option and the missing argument.
+	ExitCodeUsage = 2
+	// exitCodeSignalBase is the base of the exit code for the termination by the signal, like 130 for SIGINT.
+	exitCodeSignalBase = 128
+)
+
+type (
+	// ExitCoder is the error which has the exit code of the process.
+	// Return it from ExecFunc to exit with the specific code by Run and Main.
+	ExitCoder interface {
+		error
+		ExitCode() int
+	}
+
+	// ExitError is the ExitCoder which wraps the error with the exit code.
+	ExitError struct {
+		// Code is the exit code.
+		Code int
+		// Err is the underlying error. It may be nil.
+		Err error
+	}
+)
+
+var _ ExitCoder = (*ExitError)(nil)
+
+// NewExitError returns the ExitError which wraps err with code.
+func NewExitError(code int, err error) *ExitError {
+	return &ExitError{Code: code, Err: err}
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit code %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error { return e.Err }
+
+func (e *ExitError) ExitCode() int { return e.Code }
+
+// usageErrors is the errors caused by the wrong command line, which exit with ExitCodeUsage.
+//
+//nolint:gochecknoglobals
+var usageErrors = []error{
+	ErrUnknownOption,
+	ErrUnknownCommand,
+	ErrMissingOptionValue,
+	ErrInvalidOptionValue,
+	ErrOptionRequired,
+	ErrMutuallyExclusiveOptions,
+	ErrMissingArgument,
+	ErrTooManyArguments,
+	ErrInvalidArgument,
+	ErrUnknownConfigKey,
+}
+
+// ExitCode returns the exit code for err returned by (*Command).Exec.
+//
+// It returns ExitCodeOK for nil and ErrHelp, the code of ExitCoder if err wraps it,
+// ExitCodeUsage for the usage errors, and ExitCodeError for the others.
+func ExitCode(err error) int {
+	if err == nil || IsHelp(err) {
+		return ExitCodeOK
+	}
+
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+
+	for _, usageErr := range usageErrors {
+		if errors.Is(err, usageErr) {
+			return ExitCodeUsage
+		}
+	}
+
+	return ExitCodeError
+}
@@ -0,0 +1,47 @@
+package cliz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hakadoriya/z.go/errorz"
+	"github.com/hakadoriya/z.go/testingz/assertz"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			err      error
+			expected int
+		}{
+			{name: "nil", err: nil, expected: ExitCodeOK},
+			{name: "ErrHelp", err: errorz.Errorf("parse: %w", ErrHelp), expected: ExitCodeOK},
+			{name: "ErrUnknownOption", err: errorz.Errorf("parse: %w", ErrUnknownOption), expected: ExitCodeUsage},
+			{name: "ErrMissingArgument", err: errorz.Errorf("parse: %w", ErrMissingArgument), expected: ExitCodeUsage},
+			{name: "ExitError", err: errorz.Errorf("Exec: %w", NewExitError(3, ErrUnknownOption)), expected: 3},
+			{name: "error", err: errors.New("error"), expected: ExitCodeError},
+		}
+
+		for _, tt := range tests {
+			assertz.Equal(t, tt.expected, ExitCode(tt.err))
+		}
+	})
+}
+
+func TestExitError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewExitError(3, ErrNotConfirmed)
+		assertz.Equal(t, "not confirmed", err.Error())
+		assertz.True(t, errors.Is(err, ErrNotConfirmed))
+		assertz.Equal(t, "exit code 4", NewExitError(4, nil).Error())
+	})
+}
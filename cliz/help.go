@@ -3,8 +3,6 @@ package cliz
 import (
 	"errors"
 	"fmt"
-	"io"
-	"strconv"
 	"strings"
 
 	"github.com/hakadoriya/z.go/slicez"
@@ -74,100 +72,129 @@ func (c *Command) usage() {
 	c.DefaultUsage()
 }
 
-//nolint:cyclop,funlen,gocognit
+// DefaultUsage writes the help message rendered by HelpTemplate, or DefaultHelpTemplate, to Stderr.
+//
+// If Stderr is the terminal, the descriptions are wrapped to the terminal width,
+// and the help message is colored unless the NO_COLOR environment variable is set.
 func (c *Command) DefaultUsage() {
 	Logger.Debug("DefaultUsage: " + c.Name)
 
-	const indent = "    "
+	if err := c.renderHelp(c.Stderr(), c.getHelpStyle()); err != nil {
+		_, _ = fmt.Fprintf(c.Stderr(), "%s: failed to render help: %v\n", c.Name, err)
+	}
+}
 
-	// Usage
-	usage := "Usage:" + "\n"
-	if c.Usage != "" {
-		usage += indent + c.Usage + "\n"
-	} else {
-		usage += indent + strings.Join(c.allExecutedCommandNames, " ")
-		if len(c.Options) > 0 {
-			usage += " [options]"
-		}
-		if len(c.SubCommands) > 0 && !c.hasOnlyHiddenSubCommands() {
-			usage += " <subcommand>"
+// helpData returns the data of the help template.
+//
+//nolint:cyclop
+func (c *Command) helpData() *HelpData {
+	data := &HelpData{
+		Command:          c,
+		Usage:            c.Usage,
+		Description:      c.Description,
+		SubCommandGroups: make([]*HelpGroup, 0),
+		Plugins:          make([]*HelpEntry, 0),
+		Options:          make([]*HelpEntry, 0),
+		GlobalOptions:    make([]*HelpEntry, 0),
+		Arguments:        make([]*HelpEntry, 0),
+		Constraints:      make([]string, 0),
+		Examples:         c.Examples,
+		Deprecated:       c.deprecatedHelpEntries(),
+	}
+	if data.Usage == "" {
+		data.Usage = c.usageLine(c.allExecutedCommandNames)
+	}
+
+	subCommandsByGroup := c.getSubCommandsByGroup()
+	for _, group := range c.getGroups() {
+		helpGroup := &HelpGroup{Name: group, Entries: make([]*HelpEntry, 0)}
+		for _, subcmd := range subCommandsByGroup[group] {
+			helpGroup.Entries = append(helpGroup.Entries, &HelpEntry{Name: subcmd.getNameAndAliasesString(), Description: subcmd.Description})
 		}
-		usage += "\n"
+		data.SubCommandGroups = append(data.SubCommandGroups, helpGroup)
 	}
 
-	// Description
-	if c.Description != "" {
-		usage += "\n"
-		usage += "Description:" + "\n"
-		usage += indent + c.Description + "\n"
+	for _, p := range c.discoverPlugins() {
+		data.Plugins = append(data.Plugins, &HelpEntry{Name: p.name, Description: p.path})
 	}
 
-	// SubCommands
-	if len(c.SubCommands) > 0 && !c.hasOnlyHiddenSubCommands() {
-		usage += "\n"
-		usage += "Sub Commands:\n"
-		groups := c.getGroups()
-		subCommandsByGroup := c.getSubCommandsByGroup()
-		for _, group := range groups {
-			// If the group is set, the group name is displayed.
-			if group != "" {
-				usage += indent + group + ":\n"
-			}
-			commandsMaxWidthInGroup := c.getSubCommandListMaxWidthInGroup(group)
-			for _, subcmd := range subCommandsByGroup[group] {
-				// If the group is set, add an indent for group name.
-				if subcmd.Group != "" {
-					usage += indent
-				}
-				usage += indent + fmt.Sprintf("%-"+strconv.Itoa(commandsMaxWidthInGroup)+"s"+indent+"%s", subcmd.getNameAndAliasesString(), subcmd.Description) + "\n"
-			}
+	for _, opt := range c.Options {
+		if opt.IsHidden() || opt.GetDeprecated() != nil || c.isPersistentOption(opt) {
+			continue
 		}
+		data.Options = append(data.Options, optionHelpEntry(opt))
 	}
 
-	// Options
-	//
-	//nolint:nestif
-	if len(c.Options) > 0 {
-		usage += "\n"
-		usage += "Options:\n"
-		for _, opt := range c.Options {
-			if opt.IsHidden() {
-				continue
-			}
+	for _, opt := range c.getGlobalOptions() {
+		if opt.IsHidden() || opt.GetDeprecated() != nil {
+			continue
+		}
+		data.GlobalOptions = append(data.GlobalOptions, optionHelpEntry(opt))
+	}
 
-			name := opt.GetName()
-			env := opt.GetEnv()
-			usage += indent
-			if name != "" {
-				usage += fmt.Sprintf("%s%s", longOptionPrefix, name)
-			}
-			if aliases := opt.GetAliases(); len(aliases) > 0 {
-				for _, alias := range aliases {
-					usage += fmt.Sprintf(", %s%s", shortOptionPrefix, alias)
-				}
-			}
+	for _, arg := range c.Args {
+		attributes := ""
+		if arg.Required {
+			attributes += "required, "
+		}
+		if arg.Variadic {
+			attributes += "variadic, "
+		}
+		attributes += "type: " + arg.Type.String()
+		data.Arguments = append(data.Arguments, &HelpEntry{Name: arg.usage(), Attributes: attributes, Description: arg.GetDescription()})
+	}
 
-			usage += " ("
+	for _, group := range c.ConstraintGroups {
+		data.Constraints = append(data.Constraints, group.String())
+	}
 
-			if opt.IsRequired() {
-				usage += "required, "
-			}
+	return data
+}
 
-			if env != "" {
-				usage += fmt.Sprintf("env: %s, ", env)
-			}
+// optionHelpEntry returns the option in the help message, which is rendered like the following:
+//
+//	--foo, -f (required, env: FOO, default: bar)
+//	    description of foo
+func optionHelpEntry(opt Option) *HelpEntry {
+	name := ""
+	if optName := opt.GetName(); optName != "" {
+		name += longOptionPrefix + optName
+	}
+	for _, alias := range opt.GetAliases() {
+		name += ", " + shortOptionPrefix + alias
+	}
 
-			usage += fmt.Sprintf("default: %v", opt.GetDefault())
+	attributes := ""
+	if opt.IsRequired() {
+		attributes += "required, "
+	}
+	if env := opt.GetEnv(); env != "" {
+		attributes += fmt.Sprintf("env: %s, ", env)
+	}
+	attributes += fmt.Sprintf("default: %v", maskOptionValue(opt, opt.GetDefault()))
+	switch o := opt.(type) {
+	case *EnumOption:
+		attributes += fmt.Sprintf(", allowed: %s", strings.Join(o.Allowed, enumAllowedSeparator))
+	case *TimeOption:
+		attributes += fmt.Sprintf(", layout: %s", o.GetLayout())
+	}
 
-			usage += ")"
+	return &HelpEntry{Name: name, Attributes: attributes, Description: opt.GetDescription()}
+}
 
-			usage += "\n"
-			usage += indent + indent + opt.GetDescription() + "\n"
-		}
+// usageLine returns the default usage line, like `main-cli sub-cmd [options] <subcommand> <arg>`.
+func (c *Command) usageLine(commandNames []string) string {
+	usage := strings.Join(commandNames, " ")
+	if len(c.Options) > 0 {
+		usage += " [options]"
 	}
-
-	// Output
-	_, _ = io.WriteString(c.Stderr(), usage)
+	if len(c.SubCommands) > 0 && !c.hasOnlyHiddenSubCommands() {
+		usage += " <subcommand>"
+	}
+	for _, arg := range c.Args {
+		usage += " " + arg.usage()
+	}
+	return usage
 }
 
 func (c *Command) getNameAndAliasesString() string {
@@ -180,7 +207,7 @@ func (c *Command) getNameAndAliasesString() string {
 func (c *Command) getGroups() (groups []string) {
 	groups = make([]string, 0)
 	for _, subcmd := range c.SubCommands {
-		if subcmd.Hidden {
+		if subcmd.Hidden || subcmd.Deprecated != nil {
 			continue
 		}
 		groups = append(groups, subcmd.Group)
@@ -191,23 +218,10 @@ func (c *Command) getGroups() (groups []string) {
 func (c *Command) getSubCommandsByGroup() map[string][]*Command {
 	subCommandsByGroup := make(map[string][]*Command)
 	for _, subcmd := range c.SubCommands {
-		if subcmd.Hidden {
+		if subcmd.Hidden || subcmd.Deprecated != nil {
 			continue
 		}
 		subCommandsByGroup[subcmd.Group] = append(subCommandsByGroup[subcmd.Group], subcmd)
 	}
 	return subCommandsByGroup
 }
-
-func (c *Command) getSubCommandListMaxWidthInGroup(group string) (maxWidth int) {
-	for _, subcmd := range c.SubCommands {
-		if subcmd.Hidden || subcmd.Group != group {
-			continue
-		}
-		nameAndAliasesString := subcmd.getNameAndAliasesString()
-		if len(nameAndAliasesString) > maxWidth {
-			maxWidth = len(nameAndAliasesString)
-		}
-	}
-	return maxWidth
-}
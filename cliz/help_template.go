@@ -0,0 +1,316 @@
+package cliz
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// DefaultHelpTemplate is the text/template of the help message used when HelpTemplate of the command is empty.
+//
+// The data is *HelpData, and the following functions are available in addition to the built-in functions:
+//
+//	indent             returns the indent of the help message.
+//	add a b ...        returns the sum of the integers.
+//	pad s width        pads s with the trailing spaces to width.
+//	nameWidth entries  returns the max width of Name of the entries.
+//	wrap column s      wraps s to the terminal width, indenting the continuation lines to column.
+//	header s           colors the section header s, if the colors are enabled.
+//	name s             colors the name s, like the command and option names, if the colors are enabled.
+const DefaultHelpTemplate = `{{ header "Usage:" }}
+{{ indent }}{{ .Usage }}
+{{- if .Description }}
+
+{{ header "Description:" }}
+{{ indent }}{{ wrap (len indent) .Description }}
+{{- end }}
+{{- if .SubCommandGroups }}
+
+{{ header "Sub Commands:" }}
+{{- range .SubCommandGroups }}{{ $width := nameWidth .Entries }}{{ $groupIndent := "" }}
+{{- if .Name }}
+{{ indent }}{{ .Name }}:{{ $groupIndent = indent }}
+{{- end }}
+{{- range .Entries }}
+{{ $groupIndent }}{{ indent }}{{ name (pad .Name $width) }}{{ indent }}{{ wrap (add (len $groupIndent) (len indent) $width (len indent)) .Description }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Plugins }}{{ $width := nameWidth .Plugins }}
+
+{{ header "Plugins:" }}
+{{- range .Plugins }}
+{{ indent }}{{ name (pad .Name $width) }}{{ indent }}{{ .Description }}
+{{- end }}
+{{- end }}
+{{- if .Options }}
+
+{{ header "Options:" }}
+{{- range .Options }}
+{{ indent }}{{ name .Name }} ({{ .Attributes }})
+{{ indent }}{{ indent }}{{ wrap (add (len indent) (len indent)) .Description }}
+{{- end }}
+{{- end }}
+{{- if .GlobalOptions }}
+
+{{ header "Global Options:" }}
+{{- range .GlobalOptions }}
+{{ indent }}{{ name .Name }} ({{ .Attributes }})
+{{ indent }}{{ indent }}{{ wrap (add (len indent) (len indent)) .Description }}
+{{- end }}
+{{- end }}
+{{- if .Arguments }}
+
+{{ header "Arguments:" }}
+{{- range .Arguments }}
+{{ indent }}{{ name .Name }} ({{ .Attributes }})
+{{ indent }}{{ indent }}{{ wrap (add (len indent) (len indent)) .Description }}
+{{- end }}
+{{- end }}
+{{- if .Constraints }}
+
+{{ header "Constraints:" }}
+{{- range .Constraints }}
+{{ indent }}{{ . }}
+{{- end }}
+{{- end }}
+{{- if .Examples }}
+
+{{ header "Examples:" }}
+{{- range .Examples }}
+{{ indent }}{{ name .Command }}
+{{- if .Description }}
+{{ indent }}{{ indent }}{{ wrap (add (len indent) (len indent)) .Description }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Deprecated }}{{ $width := nameWidth .Deprecated }}
+
+{{ header "Deprecated:" }}
+{{- range .Deprecated }}
+{{ indent }}{{ name (pad .Name $width) }}{{ indent }}{{ wrap (add (len indent) $width (len indent)) .Description }}
+{{- end }}
+{{- end }}
+`
+
+const (
+	helpIndent = "    "
+
+	helpColorReset  = "\x1b[0m"
+	helpColorHeader = "\x1b[1m"
+	helpColorName   = "\x1b[36m"
+)
+
+type (
+	// HelpData is the data of the help template.
+	HelpData struct {
+		// Command is the command of the help message.
+		Command *Command
+		// Usage is the usage line, like `main-cli sub-cmd [options] <arg>`.
+		Usage string
+		// Description is the description of the command.
+		Description string
+		// SubCommandGroups is the visible subcommands grouped by Group.
+		SubCommandGroups []*HelpGroup
+		// Plugins is the discovered plugins, whose Description is the path of the executable.
+		Plugins []*HelpEntry
+		// Options is the visible options of the command.
+		Options []*HelpEntry
+		// GlobalOptions is the visible persistent options of the command and its ancestors.
+		GlobalOptions []*HelpEntry
+		// Arguments is the positional arguments of the command.
+		Arguments []*HelpEntry
+		// Constraints is the constraints among the options of the command.
+		Constraints []string
+		// Examples is the examples of the command.
+		Examples []*Example
+		// Deprecated is the deprecated subcommands, options and aliases.
+		Deprecated []*HelpEntry
+	}
+
+	// HelpGroup is the group of the subcommands in the help message.
+	HelpGroup struct {
+		// Name is the group name, which is empty for the subcommands without Group.
+		Name string
+		// Entries is the subcommands in the group.
+		Entries []*HelpEntry
+	}
+
+	// HelpEntry is the entry of the section in the help message.
+	HelpEntry struct {
+		// Name is the name of the entry, like "sub-cmd, sc" or "--foo, -f".
+		Name string
+		// Attributes is the attributes of the option or the argument, like "required, env: FOO, default: bar".
+		Attributes string
+		// Description is the description of the entry.
+		Description string
+	}
+
+	// Example is the example of the command shown in the help message.
+	Example struct {
+		// Command is the example command line, like "main-cli sub-cmd --foo=bar".
+		Command string
+		// Description is the description of the example.
+		Description string
+	}
+
+	// helpStyle is the style of the help message depending on the output.
+	helpStyle struct {
+		// color enables the colors.
+		color bool
+		// width is the terminal width to wrap the descriptions, or 0 not to wrap.
+		width int
+	}
+)
+
+// getHelpTemplate returns HelpTemplate of the command or the nearest ancestor, or DefaultHelpTemplate.
+func (c *Command) getHelpTemplate() string {
+	for p := c; p != nil; p = p.parent {
+		if p.HelpTemplate != "" {
+			return p.HelpTemplate
+		}
+	}
+	return DefaultHelpTemplate
+}
+
+// getHelpStyle returns the style for Stderr, which enables the colors and the wrapping only for the terminal.
+// The colors are disabled if the NO_COLOR environment variable is set.
+func (c *Command) getHelpStyle() helpStyle {
+	f, ok := c.Stderr().(*os.File)
+	if !ok || !isTerminal(f) {
+		return helpStyle{color: false, width: 0}
+	}
+
+	return c.getTerminalHelpStyle(f)
+}
+
+func (c *Command) getTerminalHelpStyle(f *os.File) helpStyle {
+	return helpStyle{
+		color: c.Getenv("NO_COLOR") == "",
+		width: c.terminalWidth(f),
+	}
+}
+
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+
+	// NOTE: The character devices like /dev/null are not terminals, and stty fails on them.
+	cmd := exec.Command("stty", "-g")
+	cmd.Stdin = f
+	return cmd.Run() == nil
+}
+
+// terminalWidth returns the width of the terminal f from the COLUMNS environment variable or `stty size`, or 0 if unknown.
+func (c *Command) terminalWidth(f *os.File) int {
+	if columns, err := strconv.Atoi(c.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+
+	cmd := exec.Command("stty", "size")
+	cmd.Stdin = f
+	out, err := cmd.Output()
+	if err != nil {
+		Logger.Debug("terminalWidth: stty size: " + err.Error())
+		return 0
+	}
+	// NOTE: The output is "<rows> <columns>".
+	if fields := strings.Fields(string(out)); len(fields) == 2 { //nolint:mnd
+		if columns, err := strconv.Atoi(fields[1]); err == nil {
+			return columns
+		}
+	}
+	return 0
+}
+
+func (s helpStyle) funcMap() template.FuncMap {
+	colorize := func(color string) func(string) string {
+		return func(text string) string {
+			if !s.color || text == "" {
+				return text
+			}
+			return color + text + helpColorReset
+		}
+	}
+
+	return template.FuncMap{
+		"indent": func() string { return helpIndent },
+		"add": func(values ...int) int {
+			sum := 0
+			for _, v := range values {
+				sum += v
+			}
+			return sum
+		},
+		"pad": func(text string, width int) string {
+			return text + strings.Repeat(" ", max(0, width-len(text)))
+		},
+		"nameWidth": func(entries []*HelpEntry) int {
+			width := 0
+			for _, entry := range entries {
+				width = max(width, len(entry.Name))
+			}
+			return width
+		},
+		"wrap": func(column int, text string) string {
+			return wrapText(text, s.width, column)
+		},
+		"header": colorize(helpColorHeader),
+		"name":   colorize(helpColorName),
+	}
+}
+
+// wrapText wraps each line of text to width, assuming that text starts at column,
+// and indents the continuation lines to column. If width is 0 or too narrow, text is returned as is.
+func wrapText(text string, width, column int) string {
+	// NOTE: Wrapping into a column narrower than this is harder to read than not wrapping.
+	const minWrapWidth = 20
+	if width-column < minWrapWidth {
+		return text
+	}
+
+	indent := strings.Repeat(" ", column)
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		wrapped := make([]string, 0)
+		current := ""
+		for _, word := range strings.Fields(line) {
+			switch {
+			case current == "":
+				current = word
+			case len(current)+1+len(word) <= width-column:
+				current += " " + word
+			default:
+				wrapped = append(wrapped, current)
+				current = word
+			}
+		}
+		if len(wrapped) == 0 {
+			// NOTE: The line which fits is kept as is, including the spaces.
+			continue
+		}
+		lines[i] = strings.Join(append(wrapped, current), "\n"+indent)
+	}
+	return strings.Join(lines, "\n"+indent)
+}
+
+// renderHelp writes the help message of the command to w with the style.
+func (c *Command) renderHelp(w io.Writer, style helpStyle) error {
+	tmpl, err := template.New("help").Funcs(style.funcMap()).Parse(c.getHelpTemplate())
+	if err != nil {
+		return errorz.Errorf("template.Parse: %w", err)
+	}
+
+	if err := tmpl.Execute(w, c.helpData()); err != nil {
+		return errorz.Errorf("template.Execute: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestHelpTemplateCommand() *Command {
+	return &Command{
+		Name:        "main-cli",
+		Description: "main-cli manages the resources of the service, which are created, listed and deleted by the subcommands",
+		Examples: []*Example{
+			{Command: "main-cli create --name foo", Description: "create the resource named foo"},
+			{Command: "main-cli list"},
+		},
+		Options: []Option{
+			&StringOption{Name: "name", Aliases: []string{"n"}, Description: "name of the resource"},
+		},
+		SubCommands: []*Command{
+			{Name: "create", Description: "create the resource"},
+			{Name: "list", Description: "list the resources"},
+		},
+	}
+}
+
+func TestCommand_renderHelp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,Examples", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestHelpTemplateCommand()
+		buf := bytes.NewBuffer(nil)
+		c.SetStderrRecursive(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+
+		const expected = `Usage:
+    main-cli [options] <subcommand>
+
+Description:
+    main-cli manages the resources of the service, which are created, listed and deleted by the subcommands
+
+Sub Commands:
+    create    create the resource
+    list      list the resources
+
+Options:
+    --name, -n (default: )
+        name of the resource
+    --help (default: false)
+        show help message and exit
+
+Examples:
+    main-cli create --name foo
+        create the resource named foo
+    main-cli list
+`
+		assertz.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,width", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestHelpTemplateCommand()
+		c.SubCommands[0].Description = "create the resource with the name given by the option"
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.NoError(t, err)
+		buf := bytes.NewBuffer(nil)
+		requirez.NoError(t, c.renderHelp(buf, helpStyle{color: false, width: 40}))
+
+		const expected = `Usage:
+    main-cli [options] <subcommand>
+
+Description:
+    main-cli manages the resources of
+    the service, which are created,
+    listed and deleted by the
+    subcommands
+
+Sub Commands:
+    create    create the resource with
+              the name given by the
+              option
+    list      list the resources
+
+Options:
+    --name, -n (default: )
+        name of the resource
+    --help (default: false)
+        show help message and exit
+
+Examples:
+    main-cli create --name foo
+        create the resource named foo
+    main-cli list
+`
+		assertz.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,color", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", SubCommands: []*Command{{Name: "sub-cmd", Description: "my sub command"}}}
+		c.allExecutedCommandNames = []string{"main-cli"}
+		buf := bytes.NewBuffer(nil)
+		requirez.NoError(t, c.renderHelp(buf, helpStyle{color: true, width: 0}))
+
+		const expected = "\x1b[1mUsage:\x1b[0m\n" +
+			"    main-cli <subcommand>\n" +
+			"\n" +
+			"\x1b[1mSub Commands:\x1b[0m\n" +
+			"    \x1b[36msub-cmd\x1b[0m    my sub command\n"
+		assertz.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,HelpTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestHelpTemplateCommand()
+		c.HelpTemplate = "{{ .Usage }}\n{{ range .SubCommandGroups }}{{ range .Entries }}{{ name .Name }}: {{ .Description }}\n{{ end }}{{ end }}"
+		buf := bytes.NewBuffer(nil)
+		c.SetStderrRecursive(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "create", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+
+		// NOTE: HelpTemplate of the parent command is inherited.
+		assertz.Equal(t, "main-cli create [options]\n", buf.String())
+	})
+
+	t.Run("error,HelpTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestHelpTemplateCommand()
+		c.HelpTemplate = "{{ .Unknown }}"
+		buf := bytes.NewBuffer(nil)
+		c.SetStderr(buf)
+		c.DefaultUsage()
+
+		assertz.StringContains(t, buf.String(), "main-cli: failed to render help: ")
+		requirez.ErrorContains(t, c.renderHelp(buf, helpStyle{color: false, width: 0}), "template.Execute: ")
+		c.HelpTemplate = "{{ .Usage "
+		requirez.ErrorContains(t, c.renderHelp(buf, helpStyle{color: false, width: 0}), "template.Parse: ")
+	})
+}
+
+func TestCommand_getHelpStyle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,not_terminal", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestHelpTemplateCommand()
+		c.SetStderr(bytes.NewBuffer(nil))
+		assertz.Equal(t, helpStyle{color: false, width: 0}, c.getHelpStyle())
+
+		f, err := os.Create(filepath.Join(t.TempDir(), "stderr"))
+		requirez.NoError(t, err)
+		t.Cleanup(func() { _ = f.Close() })
+		c.SetStderr(f)
+		assertz.Equal(t, helpStyle{color: false, width: 0}, c.getHelpStyle())
+	})
+
+	t.Run("success,terminal", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			env     map[string]string
+			expects helpStyle
+		}{
+			{name: "color", env: map[string]string{"COLUMNS": "80"}, expects: helpStyle{color: true, width: 80}},
+			{name: "NO_COLOR", env: map[string]string{"COLUMNS": "120", "NO_COLOR": "1"}, expects: helpStyle{color: false, width: 120}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestHelpTemplateCommand()
+				c.SetGetenv(func(key string) string { return tt.env[key] })
+				assertz.Equal(t, tt.expects, c.getTerminalHelpStyle(os.Stderr))
+			})
+		}
+	})
+}
+
+func TestWrapText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		column   int
+		expected string
+	}{
+		{name: "no_width", text: "aaa bbb ccc", width: 0, column: 4, expected: "aaa bbb ccc"},
+		{name: "too_narrow", text: "aaa bbb ccc", width: 20, column: 4, expected: "aaa bbb ccc"},
+		{name: "fit", text: "aaa  bbb ccc", width: 30, column: 4, expected: "aaa  bbb ccc"},
+		{name: "wrap", text: "aaaaaaaa bbbbbbbb cccccccc dddddddd", width: 24, column: 2, expected: "aaaaaaaa bbbbbbbb\n  cccccccc dddddddd"},
+		{name: "long_word", text: "a bbbbbbbbbbbbbbbbbbbbbbbbb c", width: 24, column: 2, expected: "a\n  bbbbbbbbbbbbbbbbbbbbbbbbb\n  c"},
+		{name: "newline", text: "aaaaaaaa\nbbbbbbbb cccccccc dddddddd", width: 24, column: 2, expected: "aaaaaaaa\n  bbbbbbbb cccccccc\n  dddddddd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assertz.Equal(t, tt.expected, wrapText(tt.text, tt.width, tt.column))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hakadoriya/z.go/testingz/requirez"
 )
@@ -179,6 +180,77 @@ Options:
         string value of required
     --help (default: false)
         show help message and exit
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+	t.Run("success,TypedOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&EnumOption{Name: "format", Allowed: []string{"json", "yaml"}, Default: "json"},
+				&TimeOption{Name: "date", Layout: time.DateOnly, Default: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+				&IPOption{Name: "addr"},
+			},
+		}
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Usage:
+    main-cli [options]
+
+Options:
+    --format (default: json, allowed: json|yaml)
+        enum value of format
+    --date (default: 2024-01-02, layout: 2006-01-02)
+        time value of date
+    --addr (default: )
+        ip value of addr
+    --help (default: false)
+        show help message and exit
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+	t.Run("success,ConstraintGroups", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&StringOption{Name: "file"},
+				&BoolOption{Name: "stdin"},
+				&StringOption{Name: "user"},
+				&StringOption{Name: "password"},
+			},
+			ConstraintGroups: []*ConstraintGroup{
+				{Type: ConstraintMutuallyExclusive, Options: []string{"file", "stdin"}},
+				{Type: ConstraintRequires, Options: []string{"user", "password"}},
+			},
+		}
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Usage:
+    main-cli [options]
+
+Options:
+    --file (default: )
+        string value of file
+    --stdin (default: false)
+        bool value of stdin
+    --user (default: )
+        string value of user
+    --password (default: )
+        string value of password
+    --help (default: false)
+        show help message and exit
+
+Constraints:
+    mutually exclusive: --file, --stdin
+    --user requires --password
 `
 		requirez.Equal(t, expected, buf.String())
 	})
@@ -4,6 +4,14 @@ import (
 	"io"
 )
 
+func (c *Command) Stdin() io.Reader {
+	if c.stdin != nil {
+		return c.stdin
+	}
+
+	return Stdin
+}
+
 func (c *Command) Stdout() io.Writer {
 	if c.stdout != nil {
 		return c.stdout
@@ -20,6 +28,14 @@ func (c *Command) Stderr() io.Writer {
 	return Stderr
 }
 
+func (c *Command) SetStdin(r io.Reader) {
+	if c == nil {
+		return
+	}
+
+	c.stdin = r
+}
+
 func (c *Command) SetStdout(w io.Writer) {
 	if c == nil {
 		return
@@ -36,6 +52,17 @@ func (c *Command) SetStderr(w io.Writer) {
 	c.stderr = w
 }
 
+func (c *Command) SetStdinRecursive(r io.Reader) {
+	if c == nil {
+		return
+	}
+
+	c.SetStdin(r)
+	for _, subcmd := range c.SubCommands {
+		subcmd.SetStdinRecursive(r)
+	}
+}
+
 func (c *Command) SetStdoutRecursive(w io.Writer) {
 	if c == nil {
 		return
@@ -8,6 +8,28 @@ import (
 	"github.com/hakadoriya/z.go/testingz/requirez"
 )
 
+func TestCommand_GetStdin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,stdin", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		buf := new(bytes.Buffer)
+		c.SetStdinRecursive(buf)
+		requirez.Equal(t, buf, c.Stdin())
+		requirez.Equal(t, buf, c.SubCommands[0].Stdin())
+	})
+
+	t.Run("success,os.Stdin", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		actual := c.Stdin()
+		requirez.Equal(t, os.Stdin, actual)
+	})
+}
+
 func TestCommand_GetStdout(t *testing.T) {
 	t.Parallel()
 
@@ -1,7 +1,12 @@
 package cliz
 
 import (
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
 )
 
 type (
@@ -21,16 +26,73 @@ type (
 		IsZero() bool
 		// IsHidden returns the hidden flag of the option.
 		IsHidden() bool
+		// IsSensitive returns the sensitive flag of the option.
+		IsSensitive() bool
+		// GetDeprecated returns the deprecation of the option, or nil if the option is not deprecated.
+		GetDeprecated() *Deprecated
+		// GetDeprecatedAliases returns the deprecated alias names of the option and their deprecations.
+		GetDeprecatedAliases() map[string]*Deprecated
 		// GetDescription returns the description of the option.
 		GetDescription() string
 	}
 )
 
+// optionValue is the option whose value cliz sets generically.
+// All the option types in this package implement it.
+type optionValue interface {
+	Option
+	// isBoolFlag returns whether the option does not need the value argument, like `--verbose`.
+	isBoolFlag() bool
+	// getValue returns the current value of the option, or nil if the value is not set.
+	getValue() interface{}
+	// validateValue calls the Validate function of the option with the current value, if both are set.
+	validateValue() error
+	// setDefault sets the default value as the value of the option.
+	setDefault() error
+	// setValue parses s and sets it as the value of the option.
+	setValue(s string) error
+	// complete returns the candidate values of the option for shell completion.
+	complete(c *Command, toComplete string) (candidates []string)
+}
+
+// optionValueResetter is the optionValue whose setValue accumulates the values, like the slice options.
+type optionValueResetter interface {
+	optionValue
+	// resetValue discards the value from the lower priority source, so that setValue does not append to it.
+	resetValue()
+}
+
+var (
+	_ optionValue         = (*StringOption)(nil)
+	_ optionValue         = (*BoolOption)(nil)
+	_ optionValue         = (*Int64Option)(nil)
+	_ optionValue         = (*Uint64Option)(nil)
+	_ optionValue         = (*Float64Option)(nil)
+	_ optionValue         = (*HelpOption)(nil)
+	_ optionValueResetter = (*StringSliceOption)(nil)
+	_ optionValueResetter = (*Int64SliceOption)(nil)
+	_ optionValueResetter = (*Uint64SliceOption)(nil)
+	_ optionValueResetter = (*Float64SliceOption)(nil)
+	_ optionValue         = (*DurationOption)(nil)
+	_ optionValue         = (*TimeOption)(nil)
+	_ optionValue         = (*IPOption)(nil)
+	_ optionValue         = (*PrefixOption)(nil)
+	_ optionValue         = (*URLOption)(nil)
+	_ optionValue         = (*EnumOption)(nil)
+	_ optionValue         = (*ValueOption)(nil)
+)
+
+func resetOptionValue(o optionValue) {
+	if r, ok := o.(optionValueResetter); ok {
+		r.resetValue()
+	}
+}
+
 // --long or -s
 func argIsHyphenOption(o Option, osArg string) bool {
 	return osArg == longOptionPrefix+o.GetName() ||
 		func() bool {
-			for _, alias := range o.GetAliases() {
+			for _, alias := range getOptionAliases(o) {
 				if osArg == shortOptionPrefix+alias || osArg == longOptionPrefix+alias {
 					return true
 				}
@@ -43,7 +105,7 @@ func argIsHyphenOption(o Option, osArg string) bool {
 func argIsHyphenOptionEqual(o Option, osArg string) bool {
 	return strings.HasPrefix(osArg, longOptionPrefix+o.GetName()+"=") ||
 		func() bool {
-			for _, alias := range o.GetAliases() {
+			for _, alias := range getOptionAliases(o) {
 				if strings.HasPrefix(osArg, shortOptionPrefix+alias+"=") || strings.HasPrefix(osArg, longOptionPrefix+alias+"=") {
 					return true
 				}
@@ -52,6 +114,24 @@ func argIsHyphenOptionEqual(o Option, osArg string) bool {
 		}()
 }
 
+// --no-long
+func argIsNegatedHyphenOption(o Option, osArg string) bool {
+	return osArg == longOptionPrefix+negatedOptionPrefix+o.GetName() ||
+		func() bool {
+			for _, alias := range getOptionAliases(o) {
+				if osArg == longOptionPrefix+negatedOptionPrefix+alias {
+					return true
+				}
+			}
+			return false
+		}()
+}
+
+// -abc
+func argIsShortOptionCluster(osArg string) bool {
+	return len(osArg) > len(shortOptionPrefix) && !strings.HasPrefix(osArg, longOptionPrefix)
+}
+
 func extractValueFromHyphenOptionEqual(osArg string) string {
 	return strings.Join(strings.Split(osArg, "=")[1:], "=")
 }
@@ -60,3 +140,47 @@ func hasNoOptionValue(osArgs []string, i int) bool {
 	lastIndex := len(osArgs) - 1
 	return i+1 > lastIndex
 }
+
+// splitCommaSeparatedValues splits the value of the slice option.
+// The value is read as a CSV record, so that an element containing commas can be quoted like `"a,b",c`.
+func splitCommaSeparatedValues(s string) ([]string, error) {
+	if s == "" {
+		return []string{}, nil
+	}
+
+	r := csv.NewReader(strings.NewReader(s))
+	r.TrimLeadingSpace = true
+	record, err := r.Read()
+	if err != nil {
+		return nil, errorz.Errorf("csv.Reader.Read: %w", err)
+	}
+
+	return record, nil
+}
+
+func parseCommaSeparatedValues[T interface{}](s string, parse func(s string) (T, error)) ([]T, error) {
+	elems, err := splitCommaSeparatedValues(s)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, 0, len(elems))
+	for _, elem := range elems {
+		v, err := parse(elem)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+// invalidOptionValueError wraps err of parsing the option value with ErrInvalidOptionValue, so that Run exits with ExitCodeUsage.
+func invalidOptionValueError(err error) error {
+	if errors.Is(err, ErrInvalidOptionValue) {
+		return err
+	}
+	// NOTE: errorz.Errorf does not support multiple %w.
+	return fmt.Errorf("%w: %w", err, ErrInvalidOptionValue)
+}
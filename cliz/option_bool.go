@@ -2,6 +2,7 @@ package cliz
 
 import (
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hakadoriya/z.go/errorz"
@@ -24,6 +25,16 @@ type (
 		Description string
 		// Hidden is the hidden flag of the option.
 		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value bool) error
 
 		// value is the value of the option.
 		value *bool
@@ -32,13 +43,16 @@ type (
 
 var _ Option = (*BoolOption)(nil)
 
-func (o *BoolOption) GetName() string         { return o.Name }
-func (o *BoolOption) GetAliases() []string    { return o.Aliases }
-func (o *BoolOption) GetEnv() string          { return o.Env }
-func (o *BoolOption) GetDefault() interface{} { return o.Default }
-func (o *BoolOption) IsRequired() bool        { return o.Required }
-func (o *BoolOption) IsZero() bool            { return o.value == nil || !*o.value }
-func (o *BoolOption) IsHidden() bool          { return o.Hidden }
+func (o *BoolOption) GetName() string                              { return o.Name }
+func (o *BoolOption) GetAliases() []string                         { return o.Aliases }
+func (o *BoolOption) GetEnv() string                               { return o.Env }
+func (o *BoolOption) GetDefault() interface{}                      { return o.Default }
+func (o *BoolOption) IsRequired() bool                             { return o.Required }
+func (o *BoolOption) IsZero() bool                                 { return o.value == nil || !*o.value }
+func (o *BoolOption) IsHidden() bool                               { return o.Hidden }
+func (o *BoolOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *BoolOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *BoolOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
 func (o *BoolOption) GetDescription() string {
 	if o.Description != "" {
 		return o.Description
@@ -46,6 +60,35 @@ func (o *BoolOption) GetDescription() string {
 	return "bool value of " + o.Name
 }
 
+func (o *BoolOption) isBoolFlag() bool { return true }
+
+func (o *BoolOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *BoolOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *BoolOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *BoolOption) setValue(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *BoolOption) complete(*Command, string) []string { return nil }
+
 func (c *Command) GetOptionBool(name string) (bool, error) {
 	v, err := c.getOptionBool(name)
 	if err != nil {
@@ -70,7 +113,7 @@ func (c *Command) getOptionBool(name string) (bool, error) {
 		}
 	}
 
-	for _, opt := range c.Options {
+	for _, opt := range c.getAcceptedOptions() {
 		if o, ok := opt.(*BoolOption); ok {
 			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
 				if o.value != nil {
@@ -0,0 +1,155 @@
+package cliz
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// ConstraintType is the type of the constraint among the options.
+type ConstraintType int
+
+const (
+	// ConstraintMutuallyExclusive means that at most one of the options can be set.
+	ConstraintMutuallyExclusive ConstraintType = iota + 1
+	// ConstraintRequiredTogether means that if any of the options is set, all of them must be set.
+	ConstraintRequiredTogether
+	// ConstraintExactlyOne means that exactly one of the options must be set.
+	ConstraintExactlyOne
+	// ConstraintAtLeastOne means that at least one of the options must be set.
+	ConstraintAtLeastOne
+	// ConstraintRequires means that if the first option is set, the rest of the options must be set.
+	ConstraintRequires
+)
+
+func (t ConstraintType) String() string {
+	switch t {
+	case ConstraintMutuallyExclusive:
+		return "mutually exclusive"
+	case ConstraintRequiredTogether:
+		return "required together"
+	case ConstraintExactlyOne:
+		return "exactly one of"
+	case ConstraintAtLeastOne:
+		return "at least one of"
+	case ConstraintRequires:
+		return "requires"
+	default:
+		return "ConstraintType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// ConstraintGroup is the constraint among the options of the same command.
+//
+// An option is regarded as set when its value comes from the command line arguments, the environment variable or the config file, not from the default.
+type ConstraintGroup struct {
+	// Type is the type of the constraint.
+	Type ConstraintType
+	// Options is the option names of the constraint.
+	Options []string
+}
+
+func (g *ConstraintGroup) String() string {
+	names := make([]string, 0, len(g.Options))
+	for _, name := range g.Options {
+		names = append(names, longOptionPrefix+name)
+	}
+
+	if g.Type == ConstraintRequires && len(names) > 0 {
+		return names[0] + " " + g.Type.String() + " " + strings.Join(names[1:], ", ")
+	}
+	return g.Type.String() + ": " + strings.Join(names, ", ")
+}
+
+func (c *Command) preCheckConstraintGroups() error {
+	for _, group := range c.ConstraintGroups {
+		if group.Type < ConstraintMutuallyExclusive || ConstraintRequires < group.Type {
+			return errorz.Errorf("%s: constraint: type %d: %w", c.Name, group.Type, ErrInvalidConstraint)
+		}
+		minOptions := 2
+		if group.Type == ConstraintExactlyOne || group.Type == ConstraintAtLeastOne {
+			minOptions = 1
+		}
+		if len(group.Options) < minOptions {
+			return errorz.Errorf("%s: constraint: %s: must have at least %d options: %w", c.Name, group, minOptions, ErrInvalidConstraint)
+		}
+		for _, name := range group.Options {
+			if c.getOptionValueByName(name) == nil {
+				return errorz.Errorf("%s: constraint: %s: %s%s: %w", c.Name, group, longOptionPrefix, name, ErrUnknownOption)
+			}
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if err := subcmd.preCheckConstraintGroups(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isOptionSet returns whether the option value is set by other than the default.
+func (c *Command) isOptionSet(name string) bool {
+	o := c.getOptionValueByName(name)
+	if o == nil {
+		return false
+	}
+	source, ok := c.optionSources[o]
+	return ok && source != OptionSourceDefault
+}
+
+//nolint:cyclop
+func (c *Command) postCheckConstraintGroups() error {
+	if len(c.allExecutedCommandNames) > 0 {
+		for _, group := range c.ConstraintGroups {
+			set, unset := make([]string, 0), make([]string, 0)
+			for _, name := range group.Options {
+				if c.isOptionSet(name) {
+					set = append(set, longOptionPrefix+name)
+				} else {
+					unset = append(unset, longOptionPrefix+name)
+				}
+			}
+
+			var err error
+			switch group.Type {
+			case ConstraintMutuallyExclusive:
+				if len(set) > 1 {
+					err = errorz.Errorf("%s: %w", strings.Join(set, ", "), ErrMutuallyExclusiveOptions)
+				}
+			case ConstraintRequiredTogether:
+				if len(set) > 0 && len(unset) > 0 {
+					err = errorz.Errorf("%s: %w", strings.Join(unset, ", "), ErrOptionRequired)
+				}
+			case ConstraintExactlyOne:
+				switch {
+				case len(set) > 1:
+					err = errorz.Errorf("%s: %w", strings.Join(set, ", "), ErrMutuallyExclusiveOptions)
+				case len(set) == 0:
+					err = errorz.Errorf("%s: %w", strings.Join(unset, ", "), ErrOptionRequired)
+				}
+			case ConstraintAtLeastOne:
+				if len(set) == 0 {
+					err = errorz.Errorf("%s: %w", strings.Join(unset, ", "), ErrOptionRequired)
+				}
+			case ConstraintRequires:
+				if c.isOptionSet(group.Options[0]) && len(unset) > 0 {
+					err = errorz.Errorf("%s: %w", strings.Join(unset, ", "), ErrOptionRequired)
+				}
+			}
+			if err != nil {
+				return errorz.Errorf("%s: constraint: %s: %w", c.Name, group, err)
+			}
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if err := subcmd.postCheckConstraintGroups(); err != nil {
+			return errorz.Errorf("%s: %w", subcmd.Name, err)
+		}
+	}
+
+	return nil
+}
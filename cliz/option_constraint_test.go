@@ -0,0 +1,139 @@
+package cliz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestConstraintCommand(groups ...*ConstraintGroup) *Command {
+	return &Command{
+		Name: "main-cli",
+		SubCommands: []*Command{
+			{
+				Name: "sub-cmd",
+				Options: []Option{
+					&StringOption{Name: "file", Default: "default.txt"},
+					&BoolOption{Name: "stdin"},
+					&StringOption{Name: "user"},
+					&StringOption{Name: "password", Env: "TEST_CONSTRAINT_PASSWORD"},
+				},
+				ConstraintGroups: groups,
+			},
+		},
+	}
+}
+
+func TestConstraintType_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.Equal(t, "exactly one of", ConstraintExactlyOne.String())
+		assertz.Equal(t, "ConstraintType(0)", ConstraintType(0).String())
+	})
+}
+
+func TestCommand_postCheckConstraintGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			group  *ConstraintGroup
+			osArgs []string
+		}{
+			{name: "MutuallyExclusive,default", group: &ConstraintGroup{Type: ConstraintMutuallyExclusive, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd", "--stdin"}},
+			{name: "RequiredTogether,none", group: &ConstraintGroup{Type: ConstraintRequiredTogether, Options: []string{"user", "password"}}, osArgs: []string{"main-cli", "sub-cmd"}},
+			{name: "RequiredTogether,all", group: &ConstraintGroup{Type: ConstraintRequiredTogether, Options: []string{"user", "password"}}, osArgs: []string{"main-cli", "sub-cmd", "--user=u", "--password=p"}},
+			{name: "ExactlyOne", group: &ConstraintGroup{Type: ConstraintExactlyOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd", "--file=a.txt"}},
+			{name: "AtLeastOne", group: &ConstraintGroup{Type: ConstraintAtLeastOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd", "--file=a.txt", "--stdin"}},
+			{name: "Requires,unset", group: &ConstraintGroup{Type: ConstraintRequires, Options: []string{"user", "password"}}, osArgs: []string{"main-cli", "sub-cmd", "--password=p"}},
+			{name: "not_executed", group: &ConstraintGroup{Type: ConstraintAtLeastOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestConstraintCommand(tt.group)
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			group  *ConstraintGroup
+			osArgs []string
+			err    error
+			errMsg string
+		}{
+			{name: "MutuallyExclusive", group: &ConstraintGroup{Type: ConstraintMutuallyExclusive, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd", "--file=a.txt", "--stdin"}, err: ErrMutuallyExclusiveOptions, errMsg: "sub-cmd: constraint: mutually exclusive: --file, --stdin: --file, --stdin: mutually exclusive options"},
+			{name: "RequiredTogether", group: &ConstraintGroup{Type: ConstraintRequiredTogether, Options: []string{"user", "password"}}, osArgs: []string{"main-cli", "sub-cmd", "--user=u"}, err: ErrOptionRequired, errMsg: "required together: --user, --password: --password: option required"},
+			{name: "ExactlyOne,none", group: &ConstraintGroup{Type: ConstraintExactlyOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd"}, err: ErrOptionRequired, errMsg: "exactly one of: --file, --stdin: --file, --stdin: option required"},
+			{name: "ExactlyOne,both", group: &ConstraintGroup{Type: ConstraintExactlyOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd", "--file=a.txt", "--stdin"}, err: ErrMutuallyExclusiveOptions},
+			{name: "AtLeastOne", group: &ConstraintGroup{Type: ConstraintAtLeastOne, Options: []string{"file", "stdin"}}, osArgs: []string{"main-cli", "sub-cmd"}, err: ErrOptionRequired},
+			{name: "Requires", group: &ConstraintGroup{Type: ConstraintRequires, Options: []string{"user", "password"}}, osArgs: []string{"main-cli", "sub-cmd", "--user=u"}, err: ErrOptionRequired, errMsg: "--user requires --password: --password: option required"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestConstraintCommand(tt.group)
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.ErrorIs(t, err, tt.err)
+				assertz.ErrorContains(t, err, tt.errMsg)
+			})
+		}
+	})
+}
+
+//nolint:paralleltest
+func TestCommand_postCheckConstraintGroups_Env(t *testing.T) {
+	t.Run("success,", func(t *testing.T) {
+		t.Setenv("TEST_CONSTRAINT_PASSWORD", "p")
+		c := newTestConstraintCommand(&ConstraintGroup{Type: ConstraintRequires, Options: []string{"user", "password"}})
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--user=u"})
+		requirez.NoError(t, err)
+	})
+}
+
+func TestCommand_preCheckConstraintGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name  string
+			group *ConstraintGroup
+			err   error
+		}{
+			{name: "type", group: &ConstraintGroup{Type: ConstraintType(0), Options: []string{"file", "stdin"}}, err: ErrInvalidConstraint},
+			{name: "too_few_options", group: &ConstraintGroup{Type: ConstraintMutuallyExclusive, Options: []string{"file"}}, err: ErrInvalidConstraint},
+			{name: "no_options", group: &ConstraintGroup{Type: ConstraintExactlyOne}, err: ErrInvalidConstraint},
+			{name: "unknown_option", group: &ConstraintGroup{Type: ConstraintMutuallyExclusive, Options: []string{"file", "unknown"}}, err: ErrUnknownOption},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestConstraintCommand(tt.group)
+				_, err := c.parse(context.Background(), []string{"main-cli"})
+				requirez.ErrorIs(t, err, tt.err)
+			})
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package cliz
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// DurationOption is the option for time.Duration value.
+	//
+	// The value is parsed by time.ParseDuration, like `--timeout=1m30s`.
+	DurationOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default time.Duration
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value time.Duration) error
+
+		// value is the value of the option.
+		value *time.Duration
+	}
+)
+
+var _ Option = (*DurationOption)(nil)
+
+func (o *DurationOption) GetName() string                              { return o.Name }
+func (o *DurationOption) GetAliases() []string                         { return o.Aliases }
+func (o *DurationOption) GetEnv() string                               { return o.Env }
+func (o *DurationOption) GetDefault() interface{}                      { return o.Default }
+func (o *DurationOption) IsRequired() bool                             { return o.Required }
+func (o *DurationOption) IsZero() bool                                 { return o.value == nil || *o.value == 0 }
+func (o *DurationOption) IsHidden() bool                               { return o.Hidden }
+func (o *DurationOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *DurationOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *DurationOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *DurationOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "duration value of " + o.Name
+}
+
+func (o *DurationOption) isBoolFlag() bool { return false }
+
+func (o *DurationOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *DurationOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *DurationOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *DurationOption) setValue(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *DurationOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionDuration(name string) (time.Duration, error) {
+	v, err := c.getOptionDuration(name)
+	if err != nil {
+		return 0, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionDuration(name string) (time.Duration, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return 0, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionDuration(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*DurationOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return 0, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
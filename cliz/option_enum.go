@@ -0,0 +1,154 @@
+package cliz
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// EnumOption is the option for string value that must be one of Allowed.
+	EnumOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		//
+		// If Default is not empty, it must be one of Allowed.
+		Default string
+		// Allowed is the allowed values of the option.
+		Allowed []string
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value string) error
+
+		// value is the value of the option.
+		value *string
+	}
+)
+
+var _ Option = (*EnumOption)(nil)
+
+func (o *EnumOption) GetName() string                              { return o.Name }
+func (o *EnumOption) GetAliases() []string                         { return o.Aliases }
+func (o *EnumOption) GetEnv() string                               { return o.Env }
+func (o *EnumOption) GetDefault() interface{}                      { return o.Default }
+func (o *EnumOption) IsRequired() bool                             { return o.Required }
+func (o *EnumOption) IsZero() bool                                 { return o.value == nil || *o.value == "" }
+func (o *EnumOption) IsHidden() bool                               { return o.Hidden }
+func (o *EnumOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *EnumOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *EnumOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *EnumOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "enum value of " + o.Name
+}
+
+func (o *EnumOption) isBoolFlag() bool { return false }
+
+func (o *EnumOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *EnumOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *EnumOption) setDefault() error {
+	if o.Default != "" {
+		if err := o.validate(o.Default); err != nil {
+			return err
+		}
+	}
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *EnumOption) validate(s string) error {
+	if !slices.Contains(o.Allowed, s) {
+		return errorz.Errorf("%q: must be one of %s: %w", s, strings.Join(o.Allowed, enumAllowedSeparator), ErrInvalidOptionValue)
+	}
+	return nil
+}
+
+func (o *EnumOption) setValue(s string) error {
+	if err := o.validate(s); err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &s
+	return nil
+}
+
+// complete returns Allowed if CompleteFunc is nil.
+func (o *EnumOption) complete(c *Command, toComplete string) []string {
+	if o.CompleteFunc == nil {
+		return o.Allowed
+	}
+	return o.CompleteFunc(c, toComplete)
+}
+
+// enumAllowedSeparator is the separator of the allowed values of EnumOption in the messages.
+const enumAllowedSeparator = "|"
+
+func (c *Command) GetOptionEnum(name string) (string, error) {
+	v, err := c.getOptionEnum(name)
+	if err != nil {
+		return "", errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionEnum(name string) (string, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return "", errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionEnum(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*EnumOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return "", errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
@@ -0,0 +1,148 @@
+package cliz
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// Float64SliceOption is the option for float64 slice value.
+	//
+	// The option can be repeated like `--ratio a --ratio b`, and the value can also be comma-separated like `--ratio=a,b`.
+	// The environment variable value is also read as comma-separated values.
+	Float64SliceOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default []float64
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value []float64) error
+
+		// value is the value of the option.
+		value *[]float64
+	}
+)
+
+var _ Option = (*Float64SliceOption)(nil)
+
+func (o *Float64SliceOption) GetName() string            { return o.Name }
+func (o *Float64SliceOption) GetAliases() []string       { return o.Aliases }
+func (o *Float64SliceOption) GetEnv() string             { return o.Env }
+func (o *Float64SliceOption) GetDefault() interface{}    { return o.Default }
+func (o *Float64SliceOption) IsRequired() bool           { return o.Required }
+func (o *Float64SliceOption) IsZero() bool               { return o.value == nil || len(*o.value) == 0 }
+func (o *Float64SliceOption) IsHidden() bool             { return o.Hidden }
+func (o *Float64SliceOption) IsSensitive() bool          { return o.Sensitive }
+func (o *Float64SliceOption) GetDeprecated() *Deprecated { return o.Deprecated }
+func (o *Float64SliceOption) GetDeprecatedAliases() map[string]*Deprecated {
+	return o.DeprecatedAliases
+}
+func (o *Float64SliceOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "float64 slice value of " + o.Name
+}
+
+func (o *Float64SliceOption) isBoolFlag() bool { return false }
+
+func (o *Float64SliceOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *Float64SliceOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *Float64SliceOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = ptr(slices.Clone(o.Default))
+	}
+	return nil
+}
+
+// resetValue discards the value from the lower priority source, so that setValue does not append to it.
+func (o *Float64SliceOption) resetValue() {
+	o.value = ptr(make([]float64, 0))
+}
+
+// setValue appends the comma-separated values in s to the value.
+func (o *Float64SliceOption) setValue(s string) error {
+	const bitSize = 64
+	v, err := parseCommaSeparatedValues(s, func(s string) (float64, error) { return strconv.ParseFloat(s, bitSize) })
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	if o.value == nil {
+		o.resetValue()
+	}
+	*o.value = append(*o.value, v...)
+	return nil
+}
+
+func (o *Float64SliceOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionFloat64Slice(name string) ([]float64, error) {
+	v, err := c.getOptionFloat64Slice(name)
+	if err != nil {
+		return nil, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionFloat64Slice(name string) ([]float64, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionFloat64Slice(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*Float64SliceOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return nil, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
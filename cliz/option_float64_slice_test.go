@@ -0,0 +1,39 @@
+package cliz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestCommand_GetOptionFloat64Slice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,ErrUnknownOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		osArgs := []string{"main-cli", "sub-cmd"}
+		_, err := c.parse(context.Background(), osArgs)
+		requirez.NoError(t, err)
+		o, err := c.GetOptionFloat64Slice("UNKNOWN")
+		assertz.Equal(t, ([]float64)(nil), o)
+		assertz.ErrorIs(t, err, ErrUnknownOption)
+		assertz.ErrorContains(t, err, fmt.Sprintf("cmd = %s: option = UNKNOWN: %s", strings.Join(osArgs, " "), ErrUnknownOption))
+	})
+}
+
+func TestFloat64SliceOption_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		o := &Float64SliceOption{}
+		requirez.True(t, o.IsZero())
+	})
+}
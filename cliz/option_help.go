@@ -2,6 +2,7 @@ package cliz
 
 import (
 	"slices"
+	"strconv"
 
 	"github.com/hakadoriya/z.go/errorz"
 )
@@ -23,13 +24,16 @@ type (
 
 var _ Option = (*HelpOption)(nil)
 
-func (o *HelpOption) GetName() string         { return o.Name }
-func (o *HelpOption) GetAliases() []string    { return o.Aliases }
-func (o *HelpOption) GetEnv() string          { return "" }
-func (o *HelpOption) GetDefault() interface{} { return false }
-func (o *HelpOption) IsRequired() bool        { return false }
-func (o *HelpOption) IsZero() bool            { return o.value == nil || !*o.value }
-func (o *HelpOption) IsHidden() bool          { return false }
+func (o *HelpOption) GetName() string                              { return o.Name }
+func (o *HelpOption) GetAliases() []string                         { return o.Aliases }
+func (o *HelpOption) GetEnv() string                               { return "" }
+func (o *HelpOption) GetDefault() interface{}                      { return false }
+func (o *HelpOption) IsRequired() bool                             { return false }
+func (o *HelpOption) IsZero() bool                                 { return o.value == nil || !*o.value }
+func (o *HelpOption) IsHidden() bool                               { return false }
+func (o *HelpOption) IsSensitive() bool                            { return false }
+func (o *HelpOption) GetDeprecated() *Deprecated                   { return nil }
+func (o *HelpOption) GetDeprecatedAliases() map[string]*Deprecated { return nil }
 func (o *HelpOption) GetDescription() string {
 	if o.Description != "" {
 		return o.Description
@@ -37,6 +41,29 @@ func (o *HelpOption) GetDescription() string {
 	return "show help message and exit"
 }
 
+func (o *HelpOption) isBoolFlag() bool { return true }
+
+func (o *HelpOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *HelpOption) validateValue() error { return nil }
+func (o *HelpOption) setDefault() error    { return nil }
+
+func (o *HelpOption) setValue(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *HelpOption) complete(*Command, string) []string { return nil }
+
 func (c *Command) getOptionHelp(name string) (bool, error) {
 	if len(c.allExecutedCommandNames) == 0 {
 		return false, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
@@ -2,6 +2,7 @@ package cliz
 
 import (
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hakadoriya/z.go/errorz"
@@ -24,6 +25,20 @@ type (
 		Description string
 		// Hidden is the hidden flag of the option.
 		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value int64) error
 
 		// value is the value of the option.
 		value *int64
@@ -32,13 +47,16 @@ type (
 
 var _ Option = (*Int64Option)(nil)
 
-func (o *Int64Option) GetName() string         { return o.Name }
-func (o *Int64Option) GetAliases() []string    { return o.Aliases }
-func (o *Int64Option) GetEnv() string          { return o.Env }
-func (o *Int64Option) GetDefault() interface{} { return o.Default }
-func (o *Int64Option) IsRequired() bool        { return o.Required }
-func (o *Int64Option) IsZero() bool            { return o.value == nil || *o.value == 0 }
-func (o *Int64Option) IsHidden() bool          { return o.Hidden }
+func (o *Int64Option) GetName() string                              { return o.Name }
+func (o *Int64Option) GetAliases() []string                         { return o.Aliases }
+func (o *Int64Option) GetEnv() string                               { return o.Env }
+func (o *Int64Option) GetDefault() interface{}                      { return o.Default }
+func (o *Int64Option) IsRequired() bool                             { return o.Required }
+func (o *Int64Option) IsZero() bool                                 { return o.value == nil || *o.value == 0 }
+func (o *Int64Option) IsHidden() bool                               { return o.Hidden }
+func (o *Int64Option) IsSensitive() bool                            { return o.Sensitive }
+func (o *Int64Option) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *Int64Option) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
 func (o *Int64Option) GetDescription() string {
 	if o.Description != "" {
 		return o.Description
@@ -46,6 +64,38 @@ func (o *Int64Option) GetDescription() string {
 	return "int64 value of " + o.Name
 }
 
+func (o *Int64Option) isBoolFlag() bool { return false }
+
+func (o *Int64Option) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *Int64Option) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *Int64Option) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *Int64Option) setValue(s string) error {
+	const base, bitSize = 10, 64
+	v, err := strconv.ParseInt(s, base, bitSize)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *Int64Option) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
 func (c *Command) GetOptionInt64(name string) (int64, error) {
 	v, err := c.getOptionInt64(name)
 	if err != nil {
@@ -70,7 +120,7 @@ func (c *Command) getOptionInt64(name string) (int64, error) {
 		}
 	}
 
-	for _, opt := range c.Options {
+	for _, opt := range c.getAcceptedOptions() {
 		if o, ok := opt.(*Int64Option); ok {
 			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
 				if o.value != nil {
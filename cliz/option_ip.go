@@ -0,0 +1,142 @@
+package cliz
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// IPOption is the option for netip.Addr value.
+	//
+	// The value is parsed by netip.ParseAddr, like `--addr=192.0.2.1` or `--addr=2001:db8::1`.
+	IPOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default netip.Addr
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value netip.Addr) error
+
+		// value is the value of the option.
+		value *netip.Addr
+	}
+)
+
+var _ Option = (*IPOption)(nil)
+
+func (o *IPOption) GetName() string                              { return o.Name }
+func (o *IPOption) GetAliases() []string                         { return o.Aliases }
+func (o *IPOption) GetEnv() string                               { return o.Env }
+func (o *IPOption) GetDefault() interface{}                      { return o.formatDefault() }
+func (o *IPOption) IsRequired() bool                             { return o.Required }
+func (o *IPOption) IsZero() bool                                 { return o.value == nil || !o.value.IsValid() }
+func (o *IPOption) IsHidden() bool                               { return o.Hidden }
+func (o *IPOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *IPOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *IPOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *IPOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "ip value of " + o.Name
+}
+
+func (o *IPOption) isBoolFlag() bool { return false }
+
+func (o *IPOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *IPOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *IPOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *IPOption) formatDefault() string {
+	if !o.Default.IsValid() {
+		return ""
+	}
+	return o.Default.String()
+}
+
+func (o *IPOption) setValue(s string) error {
+	v, err := netip.ParseAddr(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *IPOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionIP(name string) (netip.Addr, error) {
+	v, err := c.getOptionIP(name)
+	if err != nil {
+		return netip.Addr{}, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionIP(name string) (netip.Addr, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return netip.Addr{}, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionIP(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*IPOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return netip.Addr{}, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
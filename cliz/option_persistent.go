@@ -0,0 +1,53 @@
+package cliz
+
+import (
+	"slices"
+)
+
+// initAppendPersistentOptions appends PersistentOptions to Options, so that they are handled as the options of the command,
+// and sets the parent of the subcommands, so that the descendant subcommands can accept them.
+func (c *Command) initAppendPersistentOptions() {
+	for _, opt := range c.PersistentOptions {
+		if !slices.Contains(c.Options, opt) {
+			c.Options = append(c.Options, opt)
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		subcmd.parent = c
+		subcmd.initAppendPersistentOptions()
+	}
+}
+
+func (c *Command) isPersistentOption(opt Option) bool {
+	return slices.Contains(c.PersistentOptions, opt)
+}
+
+// getInheritedOptions returns PersistentOptions of the ancestor commands, in the order from the nearest ancestor.
+func (c *Command) getInheritedOptions() []Option {
+	options := make([]Option, 0)
+	for p := c.parent; p != nil; p = p.parent {
+		options = append(options, p.PersistentOptions...)
+	}
+	return options
+}
+
+// getAcceptedOptions returns the options that can be specified for the command, including the inherited options.
+func (c *Command) getAcceptedOptions() []Option {
+	return append(slices.Clip(c.Options), c.getInheritedOptions()...)
+}
+
+// getGlobalOptions returns PersistentOptions of the command and the ancestor commands.
+func (c *Command) getGlobalOptions() []Option {
+	return append(slices.Clip(c.PersistentOptions), c.getInheritedOptions()...)
+}
+
+// getOptionOwner returns the command which has opt in Options, searching from the command to the ancestors.
+func (c *Command) getOptionOwner(opt Option) *Command {
+	for p := c; p != nil; p = p.parent {
+		if slices.Contains(p.Options, opt) {
+			return p
+		}
+	}
+	return c
+}
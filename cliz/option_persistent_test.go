@@ -0,0 +1,153 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestPersistentCommand() *Command {
+	return &Command{
+		Name: "main-cli",
+		PersistentOptions: []Option{
+			&BoolOption{Name: "verbose", Aliases: []string{"v"}, Description: "verbose output"},
+			&StringSliceOption{Name: "tag"},
+		},
+		Options: []Option{
+			&StringOption{Name: "root-only"},
+		},
+		SubCommands: []*Command{
+			{
+				Name:        "sub-cmd",
+				Description: "run sub-cmd",
+				Options: []Option{
+					&StringOption{Name: "name", Default: "default"},
+				},
+				SubCommands: []*Command{
+					{
+						Name:        "sub-sub-cmd",
+						Description: "run sub-sub-cmd",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCommand_PersistentOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			osArgs  []string
+			verbose bool
+			tags    []string
+		}{
+			{name: "before_subcommand", osArgs: []string{"main-cli", "--verbose", "sub-cmd", "sub-sub-cmd"}, verbose: true, tags: nil},
+			{name: "after_subcommand", osArgs: []string{"main-cli", "sub-cmd", "--verbose", "sub-sub-cmd"}, verbose: true, tags: nil},
+			{name: "after_descendant_subcommand", osArgs: []string{"main-cli", "sub-cmd", "sub-sub-cmd", "-v"}, verbose: true, tags: nil},
+			{name: "accumulate", osArgs: []string{"main-cli", "--tag=a", "sub-cmd", "--tag=b", "sub-sub-cmd", "--tag", "c"}, verbose: false, tags: []string{"a", "b", "c"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestPersistentCommand()
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+
+				// The getters of the root command and the executed subcommand resolve the same value.
+				for _, cmd := range []*Command{c, c.SubCommands[0], c.SubCommands[0].SubCommands[0]} {
+					verbose, err := cmd.GetOptionBool("verbose")
+					requirez.NoError(t, err)
+					assertz.Equal(t, tt.verbose, verbose)
+
+					tags, err := cmd.GetOptionStringSlice("tag")
+					requirez.NoError(t, err)
+					assertz.Equal(t, tt.tags, tags)
+				}
+			})
+		}
+	})
+
+	t.Run("error,ErrUnknownOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPersistentCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--root-only=x"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+	})
+}
+
+func TestCommand_PersistentOptions_help(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,root", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPersistentCommand()
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Usage:
+    main-cli [options] <subcommand>
+
+Sub Commands:
+    sub-cmd    run sub-cmd
+
+Options:
+    --root-only (default: )
+        string value of root-only
+    --help (default: false)
+        show help message and exit
+
+Global Options:
+    --verbose, -v (default: false)
+        verbose output
+    --tag (default: [])
+        string slice value of tag
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,sub-cmd", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPersistentCommand()
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		c.SubCommands[0].SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Usage:
+    main-cli sub-cmd [options] <subcommand>
+
+Description:
+    run sub-cmd
+
+Sub Commands:
+    sub-sub-cmd    run sub-sub-cmd
+
+Options:
+    --name (default: default)
+        string value of name
+    --help (default: false)
+        show help message and exit
+
+Global Options:
+    --verbose, -v (default: false)
+        verbose output
+    --tag (default: [])
+        string slice value of tag
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+}
@@ -0,0 +1,142 @@
+package cliz
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// PrefixOption is the option for netip.Prefix value.
+	//
+	// The value is parsed by netip.ParsePrefix, like `--cidr=192.0.2.0/24`.
+	PrefixOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default netip.Prefix
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value netip.Prefix) error
+
+		// value is the value of the option.
+		value *netip.Prefix
+	}
+)
+
+var _ Option = (*PrefixOption)(nil)
+
+func (o *PrefixOption) GetName() string                              { return o.Name }
+func (o *PrefixOption) GetAliases() []string                         { return o.Aliases }
+func (o *PrefixOption) GetEnv() string                               { return o.Env }
+func (o *PrefixOption) GetDefault() interface{}                      { return o.formatDefault() }
+func (o *PrefixOption) IsRequired() bool                             { return o.Required }
+func (o *PrefixOption) IsZero() bool                                 { return o.value == nil || !o.value.IsValid() }
+func (o *PrefixOption) IsHidden() bool                               { return o.Hidden }
+func (o *PrefixOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *PrefixOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *PrefixOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *PrefixOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "prefix value of " + o.Name
+}
+
+func (o *PrefixOption) isBoolFlag() bool { return false }
+
+func (o *PrefixOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *PrefixOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *PrefixOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *PrefixOption) formatDefault() string {
+	if !o.Default.IsValid() {
+		return ""
+	}
+	return o.Default.String()
+}
+
+func (o *PrefixOption) setValue(s string) error {
+	v, err := netip.ParsePrefix(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *PrefixOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionPrefix(name string) (netip.Prefix, error) {
+	v, err := c.getOptionPrefix(name)
+	if err != nil {
+		return netip.Prefix{}, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionPrefix(name string) (netip.Prefix, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return netip.Prefix{}, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionPrefix(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*PrefixOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return netip.Prefix{}, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
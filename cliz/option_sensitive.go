@@ -0,0 +1,184 @@
+package cliz
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+	"github.com/hakadoriya/z.go/stringz"
+)
+
+const (
+	// sensitiveFileOptionSuffix is the suffix of the option to read the value of the sensitive option from the file, like `--token-file`.
+	sensitiveFileOptionSuffix = "-file"
+	// sensitiveFileEnvSuffix is the suffix of the environment variable of the file option, like `TOKEN_FILE`.
+	sensitiveFileEnvSuffix = "_FILE"
+	// sensitiveFileStdin is the file path which means stdin.
+	sensitiveFileStdin = "-"
+
+	sensitiveMask = "*"
+	// sensitiveUnmaskMinLength is the minimum length of the value whose suffix is left unmasked.
+	sensitiveUnmaskMinLength = 16
+	// sensitiveUnmaskLength is the length of the suffix left unmasked, which helps to identify the value.
+	sensitiveUnmaskLength = 4
+)
+
+// MaskSensitiveValue masks the whole value, or the value except the last 4 characters if the value is long enough.
+// It is the default of SensitiveMaskFunc.
+//
+//	MaskSensitiveValue("password")            // returns "********"
+//	MaskSensitiveValue("ghp_0123456789abcdef") // returns "****************cdef"
+func MaskSensitiveValue(s string) string {
+	if len(s) < sensitiveUnmaskMinLength {
+		return stringz.MaskPrefix(s, sensitiveMask, 0)
+	}
+	return stringz.MaskPrefix(s, sensitiveMask, sensitiveUnmaskLength)
+}
+
+// maskOptionValue returns the value to display, which is masked if the option is sensitive.
+func maskOptionValue(opt Option, v interface{}) interface{} {
+	if !opt.IsSensitive() || v == nil {
+		return v
+	}
+	s := fmt.Sprint(v)
+	if s == "" {
+		return s
+	}
+	return SensitiveMaskFunc(s)
+}
+
+// sensitiveValueError is the error whose message does not contain the value of the sensitive option.
+type sensitiveValueError struct {
+	err   error
+	value string
+}
+
+func (e *sensitiveValueError) Error() string {
+	return strings.ReplaceAll(e.err.Error(), e.value, SensitiveMaskFunc(e.value))
+}
+
+func (e *sensitiveValueError) Unwrap() error { return e.err }
+
+// maskOptionError hides value in the message of err, if the option is sensitive.
+func maskOptionError(opt Option, value string, err error) error {
+	if err == nil || !opt.IsSensitive() || value == "" {
+		return err
+	}
+	return &sensitiveValueError{err: err, value: value}
+}
+
+// initAppendSensitiveFileOptions appends the option to read the value from the file, like `--token-file`, for each sensitive option.
+func (c *Command) initAppendSensitiveFileOptions() {
+	for _, opt := range slices.Concat(c.Options, c.PersistentOptions) {
+		if !opt.IsSensitive() || c.getSensitiveFileOption(opt) != nil {
+			continue
+		}
+
+		env := ""
+		if opt.GetEnv() != "" {
+			env = opt.GetEnv() + sensitiveFileEnvSuffix
+		}
+		//nolint:exhaustruct
+		fileOption := &StringOption{
+			Name:        opt.GetName() + sensitiveFileOptionSuffix,
+			Env:         env,
+			Description: fmt.Sprintf("read the value of %s%s from the file, or stdin if %q", longOptionPrefix, opt.GetName(), sensitiveFileStdin),
+			Hidden:      opt.IsHidden(),
+		}
+
+		// NOTE: The file option of the persistent option is also persistent.
+		if c.isPersistentOption(opt) {
+			c.PersistentOptions = append(c.PersistentOptions, fileOption)
+			continue
+		}
+		c.Options = append(c.Options, fileOption)
+	}
+
+	for _, subcmd := range c.SubCommands {
+		subcmd.initAppendSensitiveFileOptions()
+	}
+}
+
+func (c *Command) getSensitiveFileOption(opt Option) *StringOption {
+	name := opt.GetName() + sensitiveFileOptionSuffix
+	for _, o := range slices.Concat(c.Options, c.PersistentOptions) {
+		if fileOption, ok := o.(*StringOption); ok && fileOption.Name == name {
+			return fileOption
+		}
+	}
+	return nil
+}
+
+//nolint:gochecknoglobals
+var optionSourcePriorities = map[OptionSource]int{
+	OptionSourceDefault:    1,
+	OptionSourceConfigFile: 2,
+	OptionSourceEnv:        3,
+	OptionSourceFile:       3,
+	OptionSourceArg:        4,
+}
+
+// loadSensitiveFiles reads the values of the sensitive options from the files specified by the file options.
+// The value from the file overrides the value from the source whose priority is lower than the file option,
+// e.g. `--token-file` overrides the environment variable of `--token`, but `--token` overrides `--token-file`.
+func (c *Command) loadSensitiveFiles() error {
+	if len(c.allExecutedCommandNames) > 0 {
+		for _, opt := range c.Options {
+			if err := c.loadSensitiveFile(opt); err != nil {
+				return errorz.Errorf("%s: %w", c.Name, err)
+			}
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if err := subcmd.loadSensitiveFiles(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Command) loadSensitiveFile(opt Option) error {
+	if !opt.IsSensitive() {
+		return nil
+	}
+	fileOption := c.getSensitiveFileOption(opt)
+	if fileOption == nil || fileOption.value == nil || *fileOption.value == "" {
+		return nil
+	}
+	if optionSourcePriorities[c.optionSources[fileOption]] <= optionSourcePriorities[c.optionSources[opt]] {
+		return nil
+	}
+
+	o, ok := opt.(optionValue)
+	if !ok {
+		return errorz.Errorf("%s%s: %w", longOptionPrefix, opt.GetName(), ErrInvalidOptionType)
+	}
+
+	path := *fileOption.value
+	var (
+		data []byte
+		err  error
+	)
+	if path == sensitiveFileStdin {
+		data, err = io.ReadAll(c.Stdin())
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return errorz.Errorf("%s%s: %s: %w", longOptionPrefix, fileOption.Name, path, err)
+	}
+
+	value := strings.TrimRight(string(data), "\r\n")
+	resetOptionValue(o)
+	if err := o.setValue(value); err != nil {
+		return errorz.Errorf("%s%s: %w", longOptionPrefix, opt.GetName(), maskOptionError(opt, value, err))
+	}
+	c.setOptionSource(opt, OptionSourceFile)
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func newTestSensitiveCommand() *Command {
+	return &Command{
+		Name: "main-cli",
+		PersistentOptions: []Option{
+			&StringOption{Name: "token", Env: "TEST_CLIZ_SENSITIVE_TOKEN", Default: "default-token-0123456789", Sensitive: true},
+		},
+		SubCommands: []*Command{
+			{
+				Name: "sub-cmd",
+				Options: []Option{
+					&Int64Option{Name: "pin", Sensitive: true, Validate: ValidateRange[int64](0, 9999)},
+				},
+			},
+		},
+	}
+}
+
+func TestMaskSensitiveValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.Equal(t, "", MaskSensitiveValue(""))
+		assertz.Equal(t, "********", MaskSensitiveValue("password"))
+		assertz.Equal(t, "****************cdef", MaskSensitiveValue("ghp_0123456789abcdef"))
+	})
+}
+
+func TestCommand_Sensitive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	requirez.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+
+	t.Run("success,file", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--token-file", tokenFile})
+		requirez.NoError(t, err)
+
+		token, err := c.GetOptionString("token")
+		requirez.NoError(t, err)
+		assertz.Equal(t, "file-token", token)
+
+		entries, err := c.GetEffectiveConfig()
+		requirez.NoError(t, err)
+		assertz.Equal(t, "token", entries[0].Option.GetName())
+		assertz.Equal(t, "**********", entries[0].Value)
+		assertz.Equal(t, OptionSourceFile, entries[0].Source)
+		assertz.Equal(t, tokenFile, entries[0].SourceDetail)
+
+		buf := new(bytes.Buffer)
+		requirez.NoError(t, c.PrintEffectiveConfig(buf))
+		assertz.False(t, strings.Contains(buf.String(), "file-token"))
+	})
+
+	t.Run("success,stdin", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		c.SetStdinRecursive(strings.NewReader("1234\r\n"))
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--pin-file=-"})
+		requirez.NoError(t, err)
+
+		pin, err := c.GetOptionInt64("pin")
+		requirez.NoError(t, err)
+		assertz.Equal(t, int64(1234), pin)
+	})
+
+	t.Run("success,arg_overrides_file", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "--token-file", tokenFile, "--token=arg-token"})
+		requirez.NoError(t, err)
+
+		token, err := c.GetOptionString("token")
+		requirez.NoError(t, err)
+		assertz.Equal(t, "arg-token", token)
+	})
+
+	t.Run("success,help", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		const expected = `Global Options:
+    --token (env: TEST_CLIZ_SENSITIVE_TOKEN, default: ********************6789)
+        string value of token
+    --token-file (env: TEST_CLIZ_SENSITIVE_TOKEN_FILE, default: )
+        read the value of --token from the file, or stdin if "-"
+`
+		assertz.StringHasSuffix(t, buf.String(), expected)
+		assertz.False(t, strings.Contains(buf.String(), "default-token"))
+	})
+
+	t.Run("error,setValue", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--pin=secret"})
+		requirez.Error(t, err)
+		assertz.StringContains(t, err.Error(), `parsing "******": invalid syntax`)
+		assertz.False(t, strings.Contains(err.Error(), "secret"))
+	})
+
+	t.Run("error,Validate", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--pin=12345"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		assertz.False(t, strings.Contains(err.Error(), "12345"))
+	})
+
+	t.Run("error,file_not_found", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestSensitiveCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "--token-file", filepath.Join(dir, "not-found")})
+		requirez.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestMarshalOptions_Sensitive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		type options struct {
+			Token string `cli:"token,sensitive"`
+			Name  string `cli:"name"`
+		}
+
+		actual, err := MarshalOptions(&options{})
+		requirez.NoError(t, err)
+		requirez.Equal(t, 2, len(actual))
+		assertz.True(t, actual[0].IsSensitive())
+		assertz.False(t, actual[1].IsSensitive())
+	})
+}
@@ -0,0 +1,135 @@
+package cliz
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// OptionSource is the source of the option value.
+type OptionSource string
+
+const (
+	// OptionSourceDefault is the source of the default value.
+	OptionSourceDefault OptionSource = "default"
+	// OptionSourceConfigFile is the source of the value from the config file.
+	OptionSourceConfigFile OptionSource = "config"
+	// OptionSourceEnv is the source of the value from the environment variable.
+	OptionSourceEnv OptionSource = "env"
+	// OptionSourceArg is the source of the value from the command line arguments.
+	OptionSourceArg OptionSource = "arg"
+	// OptionSourcePrompt is the source of the value from the interactive prompt.
+	OptionSourcePrompt OptionSource = "prompt"
+	// OptionSourceFile is the source of the value of the sensitive option from the file option, like `--token-file`.
+	OptionSourceFile OptionSource = "file"
+)
+
+// EffectiveConfigEntry is the entry of the effective config, which is the merged option value and its source.
+type EffectiveConfigEntry struct {
+	// CommandNames is the command names from the root command to the command which has the option.
+	CommandNames []string
+	// Option is the option.
+	Option Option
+	// Value is the value of the option. The value of the sensitive option is masked by SensitiveMaskFunc.
+	Value interface{}
+	// Source is the source of the value.
+	Source OptionSource
+	// SourceDetail is the environment variable name for OptionSourceEnv, or the config file path for OptionSourceConfigFile.
+	SourceDetail string
+}
+
+func (c *Command) setOptionSource(o Option, source OptionSource) {
+	if c.optionSources == nil {
+		c.optionSources = make(map[Option]OptionSource)
+	}
+	c.optionSources[o] = source
+}
+
+// GetEffectiveConfig returns the option values of the executed commands and their sources.
+// The options which have no value and the help options are not included.
+func (c *Command) GetEffectiveConfig() ([]EffectiveConfigEntry, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	return c.getEffectiveConfig(nil), nil
+}
+
+func (c *Command) getEffectiveConfig(parentNames []string) []EffectiveConfigEntry {
+	names := append(append(make([]string, 0, len(parentNames)+1), parentNames...), c.Name)
+
+	entries := make([]EffectiveConfigEntry, 0)
+	for _, opt := range c.Options {
+		o, ok := opt.(optionValue)
+		if !ok {
+			continue
+		}
+		if _, ok := o.(*HelpOption); ok {
+			continue
+		}
+		source, ok := c.optionSources[o]
+		if !ok {
+			continue
+		}
+
+		entry := EffectiveConfigEntry{
+			CommandNames: names,
+			Option:       o,
+			Value:        maskOptionValue(o, o.getValue()),
+			Source:       source,
+			SourceDetail: "",
+		}
+		switch source {
+		case OptionSourceEnv:
+			entry.SourceDetail = o.GetEnv()
+		case OptionSourceConfigFile:
+			entry.SourceDetail = c.configFilePath
+		case OptionSourceFile:
+			if fileOption := c.getSensitiveFileOption(o); fileOption != nil && fileOption.value != nil {
+				entry.SourceDetail = *fileOption.value
+			}
+		case OptionSourceDefault, OptionSourceArg, OptionSourcePrompt:
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if len(subcmd.allExecutedCommandNames) > 0 {
+			entries = append(entries, subcmd.getEffectiveConfig(names)...)
+		}
+	}
+
+	return entries
+}
+
+// PrintEffectiveConfig prints the option values of the executed commands and their sources, like the following:
+//
+//	COMMAND           OPTION    VALUE      SOURCE
+//	main-cli          --config  cfg.yaml   arg
+//	main-cli sub-cmd  --num     5          config (cfg.yaml)
+func (c *Command) PrintEffectiveConfig(w io.Writer) error {
+	entries, err := c.GetEffectiveConfig()
+	if err != nil {
+		return errorz.Errorf("GetEffectiveConfig: %w", err)
+	}
+
+	const padding = 2
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "COMMAND\tOPTION\tVALUE\tSOURCE")
+	for _, entry := range entries {
+		source := string(entry.Source)
+		if entry.SourceDetail != "" {
+			source += " (" + entry.SourceDetail + ")"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s%s\t%v\t%s\n", strings.Join(entry.CommandNames, " "), longOptionPrefix, entry.Option.GetName(), entry.Value, source)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return errorz.Errorf("tabwriter.Writer.Flush: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+//nolint:paralleltest
+func TestCommand_PrintEffectiveConfig(t *testing.T) {
+	t.Run("success,", func(t *testing.T) {
+		path := writeTestConfigFile(t, "config.yaml", "sub-cmd:\n  num: 5\n")
+		t.Setenv("TEST_CONFIG_LOG_LEVEL", "warn")
+		c := newTestConfigFileCommand(&ConfigFile{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "--config", path, "sub-cmd", "--verbose"})
+		requirez.NoError(t, err)
+
+		entries, err := c.GetEffectiveConfig()
+		requirez.NoError(t, err)
+		sources := make(map[string]OptionSource)
+		for _, entry := range entries {
+			sources[entry.Option.GetName()] = entry.Source
+		}
+		assertz.Equal(t, map[string]OptionSource{
+			"log-level": OptionSourceEnv,
+			"config":    OptionSourceArg,
+			"num":       OptionSourceConfigFile,
+			"verbose":   OptionSourceArg,
+			"tags":      OptionSourceDefault,
+		}, sources)
+
+		buf := new(bytes.Buffer)
+		requirez.NoError(t, c.PrintEffectiveConfig(buf))
+		assertz.StringHasPrefix(t, buf.String(), "COMMAND           OPTION       VALUE")
+		assertz.StringContains(t, buf.String(), "main-cli sub-cmd  --num        5")
+		assertz.StringContains(t, buf.String(), "config ("+path+")\n")
+		assertz.StringContains(t, buf.String(), "env (TEST_CONFIG_LOG_LEVEL)\n")
+	})
+
+	t.Run("error,ErrNotCalled", func(t *testing.T) {
+		c := newTestConfigFileCommand(nil)
+		err := c.PrintEffectiveConfig(new(bytes.Buffer))
+		requirez.ErrorIs(t, err, ErrNotCalled)
+	})
+}
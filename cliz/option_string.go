@@ -24,6 +24,20 @@ type (
 		Description string
 		// Hidden is the hidden flag of the option.
 		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value string) error
 
 		// value is the value of the option.
 		value *string
@@ -32,13 +46,16 @@ type (
 
 var _ Option = (*StringOption)(nil)
 
-func (o *StringOption) GetName() string         { return o.Name }
-func (o *StringOption) GetAliases() []string    { return o.Aliases }
-func (o *StringOption) GetEnv() string          { return o.Env }
-func (o *StringOption) GetDefault() interface{} { return o.Default }
-func (o *StringOption) IsRequired() bool        { return o.Required }
-func (o *StringOption) IsZero() bool            { return o.value == nil || *o.value == "" }
-func (o *StringOption) IsHidden() bool          { return o.Hidden }
+func (o *StringOption) GetName() string                              { return o.Name }
+func (o *StringOption) GetAliases() []string                         { return o.Aliases }
+func (o *StringOption) GetEnv() string                               { return o.Env }
+func (o *StringOption) GetDefault() interface{}                      { return o.Default }
+func (o *StringOption) IsRequired() bool                             { return o.Required }
+func (o *StringOption) IsZero() bool                                 { return o.value == nil || *o.value == "" }
+func (o *StringOption) IsHidden() bool                               { return o.Hidden }
+func (o *StringOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *StringOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *StringOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
 func (o *StringOption) GetDescription() string {
 	if o.Description != "" {
 		return o.Description
@@ -46,6 +63,33 @@ func (o *StringOption) GetDescription() string {
 	return "string value of " + o.Name
 }
 
+func (o *StringOption) isBoolFlag() bool { return false }
+
+func (o *StringOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *StringOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *StringOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *StringOption) setValue(s string) error {
+	o.value = &s
+	return nil
+}
+
+func (o *StringOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
 func (c *Command) GetOptionString(name string) (string, error) {
 	v, err := c.getOptionString(name)
 	if err != nil {
@@ -70,7 +114,7 @@ func (c *Command) getOptionString(name string) (string, error) {
 		}
 	}
 
-	for _, opt := range c.Options {
+	for _, opt := range c.getAcceptedOptions() {
 		if o, ok := opt.(*StringOption); ok {
 			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
 				if o.value != nil {
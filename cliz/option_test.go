@@ -10,13 +10,16 @@ type unknownOptionType struct {
 	value       *string
 }
 
-func (o *unknownOptionType) GetName() string         { return o.Name }
-func (o *unknownOptionType) GetAliases() []string    { return o.Aliases }
-func (o *unknownOptionType) GetEnv() string          { return o.Environment }
-func (o *unknownOptionType) GetDefault() interface{} { return o.Default }
-func (o *unknownOptionType) IsRequired() bool        { return o.Required }
-func (o *unknownOptionType) IsZero() bool            { return o.value == nil || *o.value == "" }
-func (o *unknownOptionType) IsHidden() bool          { return false }
+func (o *unknownOptionType) GetName() string                              { return o.Name }
+func (o *unknownOptionType) GetAliases() []string                         { return o.Aliases }
+func (o *unknownOptionType) GetEnv() string                               { return o.Environment }
+func (o *unknownOptionType) GetDefault() interface{}                      { return o.Default }
+func (o *unknownOptionType) IsRequired() bool                             { return o.Required }
+func (o *unknownOptionType) IsZero() bool                                 { return o.value == nil || *o.value == "" }
+func (o *unknownOptionType) IsHidden() bool                               { return false }
+func (o *unknownOptionType) IsSensitive() bool                            { return false }
+func (o *unknownOptionType) GetDeprecated() *Deprecated                   { return nil }
+func (o *unknownOptionType) GetDeprecatedAliases() map[string]*Deprecated { return nil }
 func (o *unknownOptionType) GetDescription() string {
 	if o.Description != "" {
 		return o.Description
@@ -0,0 +1,153 @@
+package cliz
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// TimeOption is the option for time.Time value.
+	//
+	// The value is parsed by time.Parse with Layout.
+	TimeOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default time.Time
+		// Layout is the layout to parse the value with time.Parse.
+		//
+		// If Layout is empty, time.RFC3339 is used.
+		Layout string
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value time.Time) error
+
+		// value is the value of the option.
+		value *time.Time
+	}
+)
+
+var _ Option = (*TimeOption)(nil)
+
+func (o *TimeOption) GetName() string                              { return o.Name }
+func (o *TimeOption) GetAliases() []string                         { return o.Aliases }
+func (o *TimeOption) GetEnv() string                               { return o.Env }
+func (o *TimeOption) GetDefault() interface{}                      { return o.formatDefault() }
+func (o *TimeOption) IsRequired() bool                             { return o.Required }
+func (o *TimeOption) IsZero() bool                                 { return o.value == nil || o.value.IsZero() }
+func (o *TimeOption) IsHidden() bool                               { return o.Hidden }
+func (o *TimeOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *TimeOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *TimeOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *TimeOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "time value of " + o.Name
+}
+
+func (o *TimeOption) isBoolFlag() bool { return false }
+
+func (o *TimeOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *TimeOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *TimeOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = &o.Default
+	}
+	return nil
+}
+
+func (o *TimeOption) GetLayout() string {
+	if o.Layout != "" {
+		return o.Layout
+	}
+	return time.RFC3339
+}
+
+func (o *TimeOption) formatDefault() string {
+	if o.Default.IsZero() {
+		return ""
+	}
+	return o.Default.Format(o.GetLayout())
+}
+
+func (o *TimeOption) setValue(s string) error {
+	v, err := time.Parse(o.GetLayout(), s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = &v
+	return nil
+}
+
+func (o *TimeOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionTime(name string) (time.Time, error) {
+	v, err := c.getOptionTime(name)
+	if err != nil {
+		return time.Time{}, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionTime(name string) (time.Time, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return time.Time{}, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionTime(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*TimeOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return time.Time{}, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
@@ -0,0 +1,146 @@
+package cliz
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// Uint64SliceOption is the option for uint64 slice value.
+	//
+	// The option can be repeated like `--port a --port b`, and the value can also be comma-separated like `--port=a,b`.
+	// The environment variable value is also read as comma-separated values.
+	Uint64SliceOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default []uint64
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value []uint64) error
+
+		// value is the value of the option.
+		value *[]uint64
+	}
+)
+
+var _ Option = (*Uint64SliceOption)(nil)
+
+func (o *Uint64SliceOption) GetName() string                              { return o.Name }
+func (o *Uint64SliceOption) GetAliases() []string                         { return o.Aliases }
+func (o *Uint64SliceOption) GetEnv() string                               { return o.Env }
+func (o *Uint64SliceOption) GetDefault() interface{}                      { return o.Default }
+func (o *Uint64SliceOption) IsRequired() bool                             { return o.Required }
+func (o *Uint64SliceOption) IsZero() bool                                 { return o.value == nil || len(*o.value) == 0 }
+func (o *Uint64SliceOption) IsHidden() bool                               { return o.Hidden }
+func (o *Uint64SliceOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *Uint64SliceOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *Uint64SliceOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *Uint64SliceOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "uint64 slice value of " + o.Name
+}
+
+func (o *Uint64SliceOption) isBoolFlag() bool { return false }
+
+func (o *Uint64SliceOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return *o.value
+}
+
+func (o *Uint64SliceOption) validateValue() error { return validateOptionValue(o.Validate, o.value) }
+
+func (o *Uint64SliceOption) setDefault() error {
+	if !o.IsRequired() {
+		o.value = ptr(slices.Clone(o.Default))
+	}
+	return nil
+}
+
+// resetValue discards the value from the lower priority source, so that setValue does not append to it.
+func (o *Uint64SliceOption) resetValue() {
+	o.value = ptr(make([]uint64, 0))
+}
+
+// setValue appends the comma-separated values in s to the value.
+func (o *Uint64SliceOption) setValue(s string) error {
+	const base, bitSize = 10, 64
+	v, err := parseCommaSeparatedValues(s, func(s string) (uint64, error) { return strconv.ParseUint(s, base, bitSize) })
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	if o.value == nil {
+		o.resetValue()
+	}
+	*o.value = append(*o.value, v...)
+	return nil
+}
+
+func (o *Uint64SliceOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func (c *Command) GetOptionUint64Slice(name string) ([]uint64, error) {
+	v, err := c.getOptionUint64Slice(name)
+	if err != nil {
+		return nil, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionUint64Slice(name string) ([]uint64, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionUint64Slice(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*Uint64SliceOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return *o.value, nil
+				}
+			}
+		}
+	}
+
+	return nil, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
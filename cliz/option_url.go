@@ -0,0 +1,162 @@
+package cliz
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// URLOption is the option for *url.URL value.
+	//
+	// The value must be an absolute URL, like `--endpoint=https://example.com/api`.
+	URLOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option.
+		Default *url.URL
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value *url.URL) error
+
+		// value is the value of the option.
+		value *url.URL
+	}
+)
+
+var _ Option = (*URLOption)(nil)
+
+func (o *URLOption) GetName() string                              { return o.Name }
+func (o *URLOption) GetAliases() []string                         { return o.Aliases }
+func (o *URLOption) GetEnv() string                               { return o.Env }
+func (o *URLOption) GetDefault() interface{}                      { return o.formatDefault() }
+func (o *URLOption) IsRequired() bool                             { return o.Required }
+func (o *URLOption) IsZero() bool                                 { return o.value == nil || *o.value == (url.URL{}) }
+func (o *URLOption) IsHidden() bool                               { return o.Hidden }
+func (o *URLOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *URLOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *URLOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *URLOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	return "url value of " + o.Name
+}
+
+func (o *URLOption) isBoolFlag() bool { return false }
+
+func (o *URLOption) getValue() interface{} {
+	if o.value == nil {
+		return nil
+	}
+	return o.value
+}
+
+func (o *URLOption) validateValue() error {
+	if o.Validate == nil || o.IsZero() {
+		return nil
+	}
+	return o.Validate(o.value)
+}
+
+func (o *URLOption) setDefault() error {
+	if !o.IsRequired() {
+		// NOTE: Copy the default value so that the caller of GetOptionURL cannot modify the default value.
+		o.value = new(url.URL)
+		if o.Default != nil {
+			*o.value = *o.Default
+		}
+	}
+	return nil
+}
+
+func (o *URLOption) formatDefault() string {
+	if o.Default == nil {
+		return ""
+	}
+	return o.Default.String()
+}
+
+func (o *URLOption) setValue(s string) error {
+	v, err := parseAbsoluteURL(s)
+	if err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.value = v
+	return nil
+}
+
+func (o *URLOption) complete(c *Command, toComplete string) []string {
+	return completeOptionValue(o.CompleteFunc, c, toComplete)
+}
+
+func parseAbsoluteURL(s string) (*url.URL, error) {
+	v, err := url.Parse(s)
+	if err != nil {
+		return nil, invalidOptionValueError(err)
+	}
+	if !v.IsAbs() {
+		return nil, errorz.Errorf("%q: not an absolute URL: %w", s, ErrInvalidOptionValue)
+	}
+	return v, nil
+}
+
+func (c *Command) GetOptionURL(name string) (*url.URL, error) {
+	v, err := c.getOptionURL(name)
+	if err != nil {
+		return nil, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionURL(name string) (*url.URL, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionURL(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*URLOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.value != nil {
+					return o.value, nil
+				}
+			}
+		}
+	}
+
+	return nil, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
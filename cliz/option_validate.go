@@ -0,0 +1,66 @@
+package cliz
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+func validateOptionValue[T interface{}](validate func(value T) error, value *T) error {
+	if validate == nil || value == nil {
+		return nil
+	}
+	return validate(*value)
+}
+
+func (c *Command) postCheckOptionValidate() error {
+	if len(c.allExecutedCommandNames) > 0 {
+		for _, opt := range c.Options {
+			o, ok := opt.(optionValue)
+			if !ok {
+				continue
+			}
+			if err := o.validateValue(); err != nil {
+				if v := o.getValue(); v != nil {
+					err = maskOptionError(o, fmt.Sprint(v), err)
+				}
+				if !errors.Is(err, ErrInvalidOptionValue) {
+					// NOTE: errorz.Errorf does not support multiple %w.
+					err = fmt.Errorf("%w: %w", err, ErrInvalidOptionValue)
+				}
+				return errorz.Errorf("%s: option: %s%s: %w", c.Name, longOptionPrefix, o.GetName(), err)
+			}
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		if err := subcmd.postCheckOptionValidate(); err != nil {
+			return errorz.Errorf("%s: %w", subcmd.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRange returns the Validate function which checks that the value is in the range [minValue, maxValue].
+func ValidateRange[T cmp.Ordered](minValue, maxValue T) func(value T) error {
+	return func(value T) error {
+		if value < minValue || maxValue < value {
+			return errorz.Errorf("%v: must be in the range [%v, %v]: %w", value, minValue, maxValue, ErrInvalidOptionValue)
+		}
+		return nil
+	}
+}
+
+// ValidateRegexp returns the Validate function which checks that the value matches re.
+func ValidateRegexp(re *regexp.Regexp) func(value string) error {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return errorz.Errorf("%q: must match %s: %w", value, re, ErrInvalidOptionValue)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package cliz
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestValidateRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		validate := ValidateRange[int64](1, 10)
+		requirez.NoError(t, validate(1))
+		requirez.NoError(t, validate(10))
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateRange(0.5, 1.0)(1.5)
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		assertz.ErrorContains(t, err, "1.5: must be in the range [0.5, 1]")
+	})
+}
+
+func TestValidateRegexp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		requirez.NoError(t, ValidateRegexp(regexp.MustCompile(`^[a-z]+$`))("abc"))
+	})
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateRegexp(regexp.MustCompile(`^[a-z]+$`))("ABC")
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		assertz.ErrorContains(t, err, `"ABC": must match ^[a-z]+$`)
+	})
+}
+
+func TestCommand_postCheckOptionValidate(t *testing.T) {
+	t.Parallel()
+
+	errTestValidate := errors.New("test validate error")
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&Int64Option{Name: "port", Default: 8080, Validate: ValidateRange[int64](1, 65535)},
+				&StringSliceOption{Name: "tag", Validate: func(value []string) error { return nil }},
+				&URLOption{Name: "endpoint", Validate: func(value *url.URL) error { return errTestValidate }},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--port", "443"})
+		requirez.NoError(t, err)
+	})
+
+	t.Run("error,ValidateRange", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			SubCommands: []*Command{
+				{
+					Name:    "sub-cmd",
+					Options: []Option{&Int64Option{Name: "port", Validate: ValidateRange[int64](1, 65535)}},
+				},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--port", "0"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		assertz.ErrorContains(t, err, "sub-cmd: option: --port: 0: must be in the range [1, 65535]: invalid option value")
+	})
+
+	t.Run("error,custom", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name:    "main-cli",
+			Options: []Option{&ValueOption{Name: "size", Value: new(testByteSize), Validate: func(Value) error { return errTestValidate }}},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--size", "1Ki"})
+		requirez.ErrorIs(t, err, errTestValidate)
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+	})
+}
@@ -0,0 +1,187 @@
+package cliz
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// Value is the interface to the value of ValueOption.
+	// Implement it to add a custom option type, like flag.Value.
+	Value interface {
+		// String returns the string representation of the value.
+		String() string
+		// Set parses s and sets it as the value.
+		//
+		// Set is called with Default first, then with the environment variable value,
+		// then with each command line argument value in order.
+		Set(s string) error
+		// Type returns the type name of the value, which is displayed in the help message.
+		Type() string
+	}
+
+	// BoolValue is the Value that does not need the value argument, like `--verbose`.
+	// If IsBoolFlag returns true, `--verbose` is equivalent to `--verbose=true`.
+	BoolValue interface {
+		Value
+		IsBoolFlag() bool
+	}
+
+	// CompletableValue is the Value that provides the candidate values for shell completion.
+	// It is used if CompleteFunc of ValueOption is nil.
+	CompletableValue interface {
+		Value
+		// Complete returns the candidate values. toComplete is the word under the cursor, which may be empty.
+		Complete(toComplete string) (candidates []string)
+	}
+
+	// ValueOption is the option for the custom type that implements Value.
+	ValueOption struct {
+		// Name is the name of the option.
+		Name string
+		// Aliases is the alias names of the option.
+		Aliases []string
+		// Env is the environment variable name of the option.
+		Env string
+		// Default is the default value of the option in the string representation.
+		//
+		// If Default is not empty, it is passed to (Value).Set before the environment variable and the command line arguments.
+		Default string
+		// Required is the required flag of the option.
+		Required bool
+		// Description is the description of the option.
+		Description string
+		// Hidden is the hidden flag of the option.
+		Hidden bool
+		// Sensitive is the sensitive flag of the option, like passwords and tokens.
+		//
+		// The value is read without echo when it is prompted.
+		Sensitive bool
+		// Deprecated is the deprecation of the option. The option still works, but a warning is printed when it is used.
+		Deprecated *Deprecated
+		// DeprecatedAliases is the deprecated alias names of the option, which are accepted in addition to Aliases.
+		DeprecatedAliases map[string]*Deprecated
+		// CompleteFunc returns the candidate values of the option for shell completion.
+		//
+		// toComplete is the word under the cursor, which may be empty.
+		CompleteFunc func(c *Command, toComplete string) (candidates []string)
+		// Validate validates the value of the option after the value is loaded from all the sources.
+		Validate func(value Value) error
+		// Value is the value of the option. It must not be nil.
+		Value Value
+
+		// valueSet is whether (Value).Set has been called.
+		valueSet bool
+	}
+)
+
+var _ Option = (*ValueOption)(nil)
+
+func (o *ValueOption) GetName() string                              { return o.Name }
+func (o *ValueOption) GetAliases() []string                         { return o.Aliases }
+func (o *ValueOption) GetEnv() string                               { return o.Env }
+func (o *ValueOption) GetDefault() interface{}                      { return o.Default }
+func (o *ValueOption) IsRequired() bool                             { return o.Required }
+func (o *ValueOption) IsZero() bool                                 { return o.Value == nil || !o.valueSet }
+func (o *ValueOption) IsHidden() bool                               { return o.Hidden }
+func (o *ValueOption) IsSensitive() bool                            { return o.Sensitive }
+func (o *ValueOption) GetDeprecated() *Deprecated                   { return o.Deprecated }
+func (o *ValueOption) GetDeprecatedAliases() map[string]*Deprecated { return o.DeprecatedAliases }
+func (o *ValueOption) GetDescription() string {
+	if o.Description != "" {
+		return o.Description
+	}
+	if o.Value == nil {
+		return "value of " + o.Name
+	}
+	return o.Value.Type() + " value of " + o.Name
+}
+
+func (o *ValueOption) isBoolFlag() bool {
+	if v, ok := o.Value.(BoolValue); ok {
+		return v.IsBoolFlag()
+	}
+	return false
+}
+
+func (o *ValueOption) getValue() interface{} {
+	if o.Value == nil || !o.valueSet {
+		return nil
+	}
+	return o.Value
+}
+
+func (o *ValueOption) validateValue() error {
+	if o.Validate == nil || !o.valueSet {
+		return nil
+	}
+	return o.Validate(o.Value)
+}
+
+func (o *ValueOption) setDefault() error {
+	if o.Value == nil {
+		return errorz.Errorf("Value is nil: %w", ErrInvalidOptionType)
+	}
+	if o.Default != "" && !o.IsRequired() {
+		return o.setValue(o.Default)
+	}
+	return nil
+}
+
+func (o *ValueOption) setValue(s string) error {
+	if err := o.Value.Set(s); err != nil {
+		return invalidOptionValueError(err)
+	}
+	o.valueSet = true
+	return nil
+}
+
+// complete returns the candidates by CompleteFunc, or by Value if it implements CompletableValue.
+func (o *ValueOption) complete(c *Command, toComplete string) []string {
+	if o.CompleteFunc != nil {
+		return o.CompleteFunc(c, toComplete)
+	}
+	if v, ok := o.Value.(CompletableValue); ok {
+		return v.Complete(toComplete)
+	}
+	return nil
+}
+
+func (c *Command) GetOptionValue(name string) (Value, error) {
+	v, err := c.getOptionValue(name)
+	if err != nil {
+		return nil, errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	return v, nil
+}
+
+//nolint:cyclop
+func (c *Command) getOptionValue(name string) (Value, error) {
+	if len(c.allExecutedCommandNames) == 0 {
+		return nil, errorz.Errorf("%s: %w", c.Name, ErrNotCalled)
+	}
+
+	// Search the contents of the subcommand in reverse order and prioritize the options of the descendant commands.
+	for i := range c.SubCommands {
+		subcmd := c.SubCommands[len(c.SubCommands)-1-i]
+		v, err := subcmd.getOptionValue(name)
+		if err == nil {
+			return v, nil
+		}
+	}
+
+	for _, opt := range c.getAcceptedOptions() {
+		if o, ok := opt.(*ValueOption); ok {
+			if (o.Name != "" && o.Name == name) || (len(o.Aliases) > 0 && slices.Contains(o.Aliases, name)) || (o.Env != "" && o.Env == name) {
+				if o.Value != nil {
+					return o.Value, nil
+				}
+			}
+		}
+	}
+
+	return nil, errorz.Errorf("option = %s: %w", name, ErrUnknownOption)
+}
@@ -0,0 +1,128 @@
+package cliz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+var errTestByteSizeInvalid = errors.New("invalid byte size")
+
+// testByteSize is the Value for the test, which parses "1Ki" or "1Mi".
+type testByteSize int64
+
+func (b *testByteSize) String() string { return strconv.FormatInt(int64(*b), 10) }
+func (b *testByteSize) Type() string   { return "bytesize" }
+func (b *testByteSize) Set(s string) error {
+	for suffix, unit := range map[string]int64{"Ki": 1 << 10, "Mi": 1 << 20} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			v, err := strconv.ParseInt(n, 10, 64)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+			*b = testByteSize(v * unit)
+			return nil
+		}
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%q: %w", s, errTestByteSizeInvalid)
+	}
+	*b = testByteSize(v)
+	return nil
+}
+
+// testSwitch is the BoolValue for the test.
+type testSwitch string
+
+func (s *testSwitch) String() string   { return string(*s) }
+func (s *testSwitch) Type() string     { return "switch" }
+func (s *testSwitch) IsBoolFlag() bool { return true }
+func (s *testSwitch) Set(v string) error {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	*s = testSwitch(map[bool]string{true: "on", false: "off"}[b])
+	return nil
+}
+
+func TestCommand_GetOptionValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			Options: []Option{
+				&ValueOption{Name: "size", Default: "1Ki", Value: new(testByteSize)},
+				&ValueOption{Name: "limit", Value: new(testByteSize)},
+				&ValueOption{Name: "switch", Value: new(testSwitch)},
+			},
+		}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--limit=2Mi", "--switch"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "1024", discard(c.GetOptionValue("size")).String())
+		assertz.Equal(t, "2097152", discard(c.GetOptionValue("limit")).String())
+		assertz.Equal(t, "on", discard(c.GetOptionValue("switch")).String())
+	})
+
+	t.Run("error,Set", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", Options: []Option{&ValueOption{Name: "size", Value: new(testByteSize)}}}
+		_, err := c.parse(context.Background(), []string{"main-cli", "--size", "FAILURE"})
+		requirez.ErrorIs(t, err, errTestByteSizeInvalid)
+		assertz.ErrorContains(t, err, `--size: "FAILURE": invalid byte size`)
+	})
+
+	t.Run("error,nil_Value", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", Options: []Option{&ValueOption{Name: "size"}}}
+		_, err := c.parse(context.Background(), []string{"main-cli"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionType)
+	})
+
+	t.Run("error,ErrUnknownOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		osArgs := []string{"main-cli", "sub-cmd"}
+		_, err := c.parse(context.Background(), osArgs)
+		requirez.NoError(t, err)
+		o, err := c.GetOptionValue("UNKNOWN")
+		assertz.Equal(t, Value(nil), o)
+		assertz.ErrorIs(t, err, ErrUnknownOption)
+		assertz.ErrorContains(t, err, fmt.Sprintf("cmd = %s: option = UNKNOWN: %s", strings.Join(osArgs, " "), ErrUnknownOption))
+	})
+}
+
+func TestValueOption_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		o := &ValueOption{}
+		requirez.True(t, o.IsZero())
+	})
+}
+
+func TestValueOption_GetDescription(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		o := &ValueOption{Name: "size", Value: new(testByteSize)}
+		requirez.Equal(t, "bytesize value of size", o.GetDescription())
+	})
+}
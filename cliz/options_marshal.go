@@ -2,9 +2,12 @@ package cliz
 
 import (
 	"fmt"
+	"net/netip"
+	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hakadoriya/z.go/stringz"
 )
@@ -17,6 +20,9 @@ type marshalConfig struct {
 	requiredKey    string
 	descriptionKey string
 	hiddenKey      string
+	sensitiveKey   string
+	enumKey        string
+	layoutKey      string
 }
 
 type MarshalOptionsOption interface {
@@ -107,6 +113,42 @@ func WithMarshalOptionsOptionHiddenKey(key string) MarshalOptionsOption {
 	return &withMarshalOptionsOptionHiddenKey{hiddenKey: key}
 }
 
+type withMarshalOptionsOptionSensitiveKey struct {
+	sensitiveKey string
+}
+
+func (w *withMarshalOptionsOptionSensitiveKey) apply(c *marshalConfig) {
+	c.sensitiveKey = w.sensitiveKey
+}
+
+func WithMarshalOptionsOptionSensitiveKey(key string) MarshalOptionsOption {
+	return &withMarshalOptionsOptionSensitiveKey{sensitiveKey: key}
+}
+
+type withMarshalOptionsOptionEnumKey struct {
+	enumKey string
+}
+
+func (w *withMarshalOptionsOptionEnumKey) apply(c *marshalConfig) {
+	c.enumKey = w.enumKey
+}
+
+func WithMarshalOptionsOptionEnumKey(key string) MarshalOptionsOption {
+	return &withMarshalOptionsOptionEnumKey{enumKey: key}
+}
+
+type withMarshalOptionsOptionLayoutKey struct {
+	layoutKey string
+}
+
+func (w *withMarshalOptionsOptionLayoutKey) apply(c *marshalConfig) {
+	c.layoutKey = w.layoutKey
+}
+
+func WithMarshalOptionsOptionLayoutKey(key string) MarshalOptionsOption {
+	return &withMarshalOptionsOptionLayoutKey{layoutKey: key}
+}
+
 // MarshalOptions generates the options from the structure pointer.
 // This function reads the `cliz` tag set in the structure field and generates the options.
 //
@@ -120,6 +162,9 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 		requiredKey:    DefaultRequiredKey,
 		descriptionKey: DefaultDescriptionKey,
 		hiddenKey:      DefaultHiddenKey,
+		sensitiveKey:   DefaultSensitiveKey,
+		enumKey:        DefaultEnumKey,
+		layoutKey:      DefaultLayoutKey,
 	}
 
 	for _, opt := range opts {
@@ -162,6 +207,7 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 			required    bool
 			description string
 			hidden      bool
+			sensitive   bool
 		)
 
 		if key, ok := optsContainsAliasKey(cfg, opts); ok {
@@ -182,10 +228,35 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 			hidden = true
 		}
 
+		if optsContainsSensitive(cfg, opts) {
+			sensitive = true
+		}
+
 		if key, ok := optsContainsDescription(cfg, opts); ok {
 			description = key
 		}
 
+		tag := &marshalOptionTag{
+			name:              optName,
+			aliases:           aliases,
+			env:               envKey,
+			defaultValue:      defaultValue,
+			defaultValueIsSet: defaultValueIsSet,
+			required:          required,
+			description:       description,
+			hidden:            hidden,
+			sensitive:         sensitive,
+			enum:              optsContainsEnum(cfg, opts),
+			layout:            optsContainsLayout(cfg, opts),
+		}
+
+		if opt, ok, err := marshalTypedOption(field.Type, tag); err != nil {
+			return nil, fmt.Errorf("type=%s: field=%s: fieldType=%s: tag=%s: %w", valType, field.Name, field.Type, cfg.tagKey, err)
+		} else if ok {
+			options = append(options, opt)
+			continue
+		}
+
 		const base, bitSize = 10, 64
 		//nolint:exhaustive
 		switch fieldValue.Kind() {
@@ -199,6 +270,7 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 				Required:    required,
 				Description: description,
 				Hidden:      hidden,
+				Sensitive:   sensitive,
 			})
 		case reflect.Bool: // bool
 			var defaultValueBool bool
@@ -268,6 +340,12 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 				Required:    required,
 				Description: description,
 			})
+		case reflect.Slice: // []string, []int64, []uint64, []float64, etc.
+			opt, err := marshalSliceOption(field.Type.Elem().Kind(), tag)
+			if err != nil {
+				return nil, fmt.Errorf("type=%s: field=%s: fieldType=%s: tag=%s: %w", valType, field.Name, field.Type, cfg.tagKey, err)
+			}
+			options = append(options, opt)
 		default:
 			return nil, fmt.Errorf("type=%s: field=%s: fieldType=%s: tag=%s: %w", valType, field.Name, field.Type, cfg.tagKey, ErrStructFieldTypeNotSupported)
 		}
@@ -276,6 +354,267 @@ func MarshalOptions(v interface{}, opts ...MarshalOptionsOption) (options []Opti
 	return options, nil
 }
 
+// marshalOptionTag is the parsed struct tag of the option field.
+type marshalOptionTag struct {
+	name              string
+	aliases           []string
+	env               string
+	defaultValue      string
+	defaultValueIsSet bool
+	required          bool
+	description       string
+	hidden            bool
+	sensitive         bool
+	enum              []string
+	layout            string
+}
+
+//nolint:gochecknoglobals
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+	addrType     = reflect.TypeOf(netip.Addr{})
+	prefixType   = reflect.TypeOf(netip.Prefix{})
+	urlType      = reflect.TypeOf((*url.URL)(nil))
+	valueType    = reflect.TypeOf((*Value)(nil)).Elem()
+)
+
+// newValueForType returns the new Value for the field type, if the type or the pointer to the type implements Value.
+func newValueForType(fieldType reflect.Type) (v Value, ok bool) {
+	switch {
+	case fieldType.Kind() == reflect.Ptr && fieldType.Implements(valueType): // *T implements Value and the field is *T
+		v, ok = reflect.New(fieldType.Elem()).Interface().(Value)
+	case reflect.PointerTo(fieldType).Implements(valueType): // *T implements Value and the field is T
+		v, ok = reflect.New(fieldType).Interface().(Value)
+	}
+	return v, ok
+}
+
+// marshalTypedOption generates the option for the field types that are not determined by reflect.Kind only.
+//
+//nolint:cyclop,funlen
+func marshalTypedOption(fieldType reflect.Type, tag *marshalOptionTag) (opt Option, ok bool, err error) {
+	if v, ok := newValueForType(fieldType); ok { // the type that implements Value
+		//nolint:exhaustruct
+		return &ValueOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     tag.defaultValue,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+			Value:       v,
+		}, true, nil
+	}
+
+	switch {
+	case fieldType == durationType: // time.Duration
+		var defaultValueDuration time.Duration
+		if tag.defaultValueIsSet {
+			defaultValueDuration, err = time.ParseDuration(tag.defaultValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+		}
+		//nolint:exhaustruct
+		return &DurationOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValueDuration,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, true, nil
+	case fieldType == timeType: // time.Time
+		//nolint:exhaustruct
+		o := &TimeOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Layout:      tag.layout,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}
+		if tag.defaultValueIsSet {
+			o.Default, err = time.Parse(o.GetLayout(), tag.defaultValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+		}
+		return o, true, nil
+	case fieldType == addrType: // netip.Addr
+		var defaultValueAddr netip.Addr
+		if tag.defaultValueIsSet {
+			defaultValueAddr, err = netip.ParseAddr(tag.defaultValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+		}
+		//nolint:exhaustruct
+		return &IPOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValueAddr,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, true, nil
+	case fieldType == prefixType: // netip.Prefix
+		var defaultValuePrefix netip.Prefix
+		if tag.defaultValueIsSet {
+			defaultValuePrefix, err = netip.ParsePrefix(tag.defaultValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+		}
+		//nolint:exhaustruct
+		return &PrefixOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValuePrefix,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, true, nil
+	case fieldType == urlType: // *url.URL
+		var defaultValueURL *url.URL
+		if tag.defaultValueIsSet {
+			defaultValueURL, err = parseAbsoluteURL(tag.defaultValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+		}
+		//nolint:exhaustruct
+		return &URLOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValueURL,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, true, nil
+	case fieldType.Kind() == reflect.String && len(tag.enum) > 0: // string with enum
+		//nolint:exhaustruct
+		return &EnumOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     tag.defaultValue,
+			Allowed:     tag.enum,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// sliceTagDefaultSeparator is the separator of the default values of the slice option in the struct tag.
+// The comma cannot be used because it separates the tag options.
+// The allowed values of the enum option in the struct tag are also separated by it.
+const sliceTagDefaultSeparator = ";"
+
+//nolint:cyclop,funlen
+func marshalSliceOption(elemKind reflect.Kind, tag *marshalOptionTag) (Option, error) {
+	var defaultValues []string
+	if tag.defaultValueIsSet {
+		defaultValues = strings.Split(tag.defaultValue, sliceTagDefaultSeparator)
+	}
+
+	const base, bitSize = 10, 64
+	//nolint:exhaustive
+	switch elemKind {
+	case reflect.String: // []string
+		//nolint:exhaustruct
+		return &StringSliceOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValues,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64: // []int, []int8, []int16, []int32, []int64
+		defaultValuesInt64 := make([]int64, 0, len(defaultValues))
+		for _, v := range defaultValues {
+			i, err := strconv.ParseInt(v, base, bitSize)
+			if err != nil {
+				return nil, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+			defaultValuesInt64 = append(defaultValuesInt64, i)
+		}
+		//nolint:exhaustruct
+		return &Int64SliceOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValuesInt64,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64: // []uint, []uint8, []uint16, []uint32, []uint64
+		defaultValuesUint64 := make([]uint64, 0, len(defaultValues))
+		for _, v := range defaultValues {
+			u, err := strconv.ParseUint(v, base, bitSize)
+			if err != nil {
+				return nil, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+			defaultValuesUint64 = append(defaultValuesUint64, u)
+		}
+		//nolint:exhaustruct
+		return &Uint64SliceOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValuesUint64,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, nil
+	case reflect.Float32, reflect.Float64: // []float32, []float64
+		defaultValuesFloat64 := make([]float64, 0, len(defaultValues))
+		for _, v := range defaultValues {
+			f, err := strconv.ParseFloat(v, bitSize)
+			if err != nil {
+				return nil, fmt.Errorf("default=%s: %w", tag.defaultValue, err)
+			}
+			defaultValuesFloat64 = append(defaultValuesFloat64, f)
+		}
+		//nolint:exhaustruct
+		return &Float64SliceOption{
+			Name:        tag.name,
+			Aliases:     tag.aliases,
+			Env:         tag.env,
+			Default:     defaultValuesFloat64,
+			Required:    tag.required,
+			Description: tag.description,
+			Hidden:      tag.hidden,
+			Sensitive:   tag.sensitive,
+		}, nil
+	default:
+		return nil, ErrStructFieldTypeNotSupported
+	}
+}
+
 func optsContainsAliasKey(c *marshalConfig, opts []string) (aliasKey string, hasAlias bool) {
 	for _, opt := range opts {
 		if strings.HasPrefix(opt, c.aliasKey+"=") {
@@ -327,6 +666,16 @@ func optsContainsHidden(c *marshalConfig, opts []string) bool {
 	return false
 }
 
+func optsContainsSensitive(c *marshalConfig, opts []string) bool {
+	for _, opt := range opts {
+		if opt == c.sensitiveKey {
+			return true
+		}
+	}
+
+	return false
+}
+
 func optsContainsDescription(c *marshalConfig, opts []string) (description string, hasDescription bool) {
 	for _, opt := range opts {
 		Logger.Debug("opt=" + opt)
@@ -337,3 +686,23 @@ func optsContainsDescription(c *marshalConfig, opts []string) (description strin
 
 	return "", false
 }
+
+func optsContainsEnum(c *marshalConfig, opts []string) (allowed []string) {
+	for _, opt := range opts {
+		if v, ok := strings.CutPrefix(opt, c.enumKey+"="); ok {
+			return strings.Split(v, sliceTagDefaultSeparator)
+		}
+	}
+
+	return nil
+}
+
+func optsContainsLayout(c *marshalConfig, opts []string) (layout string) {
+	for _, opt := range opts {
+		if v, ok := strings.CutPrefix(opt, c.layoutKey+"="); ok {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -1,7 +1,10 @@
 package cliz
 
 import (
+	"net/netip"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hakadoriya/z.go/testingz/assertz"
 	"github.com/hakadoriya/z.go/testingz/requirez"
@@ -17,6 +20,18 @@ type (
 	testStructParseUint struct {
 		Uint64 uint64 `cli:"uint64-opt,alias=u64,env=ENVZ_TEST_UINT64,default=INVALID"`
 	}
+	testStructParseInt64Slice struct {
+		Int64s []int64 `cli:"int64s-opt,default=1;INVALID"`
+	}
+	testStructSliceFieldTypeNotSupported struct {
+		Bools []bool `cli:"bools-opt"`
+	}
+	testStructParseDuration struct {
+		Duration time.Duration `cli:"duration-opt,default=INVALID"`
+	}
+	testStructParseURL struct {
+		URL *url.URL `cli:"url-opt,default=/relative"`
+	}
 	testStructParseFloat struct {
 		Float64 float64 `cli:"float64-opt,alias=f64,env=ENVZ_TEST_FLOAT64,default=INVALID"`
 	}
@@ -151,4 +166,64 @@ func TestMarshalOptions(t *testing.T) {
 		_, err := MarshalOptions(&v)
 		requirez.ErrorIs(t, err, ErrStructFieldTypeNotSupported)
 	})
+	t.Run("success,slice", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := MarshalOptions(&testStructSlice{})
+		requirez.NoError(t, err)
+		assertz.Equal(t, 4, len(actual))
+		assertz.Equal(t, "strings-opt", actual[0].(*StringSliceOption).Name)
+		assertz.Equal(t, []string{"ss"}, actual[0].(*StringSliceOption).Aliases)
+		assertz.Equal(t, "ENVZ_TEST_STRINGS", actual[0].(*StringSliceOption).Env)
+		assertz.Equal(t, []string{"a", "b"}, actual[0].(*StringSliceOption).Default)
+		assertz.Equal(t, "strings description", actual[0].(*StringSliceOption).Description)
+		assertz.Equal(t, []int64{1, 2}, actual[1].(*Int64SliceOption).Default)
+		assertz.Equal(t, "uints-opt", actual[2].(*Uint64SliceOption).Name)
+		assertz.True(t, actual[3].(*Float64SliceOption).Hidden)
+	})
+
+	t.Run("error,slice,default,strconv.ParseInt", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructParseInt64Slice
+		_, err := MarshalOptions(&v)
+		requirez.ErrorContains(t, err, `strconv.ParseInt: parsing "INVALID": invalid syntax`)
+	})
+
+	t.Run("error,slice,ErrFieldTypeNotSupported", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructSliceFieldTypeNotSupported
+		_, err := MarshalOptions(&v)
+		requirez.ErrorIs(t, err, ErrStructFieldTypeNotSupported)
+	})
+	t.Run("success,typed", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := MarshalOptions(&testStructTyped{})
+		requirez.NoError(t, err)
+		assertz.Equal(t, 6, len(actual))
+		assertz.Equal(t, time.Second, actual[0].(*DurationOption).Default)
+		assertz.Equal(t, "2006-01-02", actual[1].(*TimeOption).Layout)
+		assertz.Equal(t, netip.MustParseAddr("192.0.2.1"), actual[2].(*IPOption).Default)
+		assertz.Equal(t, "prefix-opt", actual[3].(*PrefixOption).Name)
+		assertz.Equal(t, "https://example.com", actual[4].(*URLOption).Default.String())
+		assertz.Equal(t, []string{"json", "yaml"}, actual[5].(*EnumOption).Allowed)
+	})
+
+	t.Run("error,default,time.ParseDuration", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructParseDuration
+		_, err := MarshalOptions(&v)
+		requirez.ErrorContains(t, err, `time: invalid duration "INVALID"`)
+	})
+
+	t.Run("error,default,URL", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructParseURL
+		_, err := MarshalOptions(&v)
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+	})
 }
@@ -1,16 +1,20 @@
 package cliz
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hakadoriya/z.go/slicez"
 	"github.com/hakadoriya/z.go/stringz"
 )
 
 type unmarshalConfig struct {
-	tagKey string
+	tagKey    string
+	argTagKey string
 }
 
 type UnmarshalOptionsOption interface {
@@ -29,14 +33,28 @@ func WithUnmarshalOptionsOptionTagKey(tagKey string) UnmarshalOptionsOption {
 	return &withUnmarshalOptionsOptionTagKey{tagKey: tagKey}
 }
 
+type withUnmarshalOptionsOptionArgTagKey struct {
+	argTagKey string
+}
+
+func (w *withUnmarshalOptionsOptionArgTagKey) apply(c *unmarshalConfig) {
+	c.argTagKey = w.argTagKey
+}
+
+func WithUnmarshalOptionsOptionArgTagKey(argTagKey string) UnmarshalOptionsOption {
+	return &withUnmarshalOptionsOptionArgTagKey{argTagKey: argTagKey}
+}
+
 // UnmarshalOptions sets the value read from (*Command).Options to the field of the passed structure pointer.
 // This function reads the value from (*Command).Options according to the `cli` tag set in the structure field.
 // The value of the `cli` tag specifies the key of the cliz.Option name.
+// The value of the `cliarg` tag specifies the name of the positional argument in (*Command).Args.
 //
 //nolint:funlen,cyclop
 func UnmarshalOptions(c *Command, v interface{}, opts ...UnmarshalOptionsOption) error {
 	cfg := &unmarshalConfig{
-		tagKey: DefaultTagKey,
+		tagKey:    DefaultTagKey,
+		argTagKey: DefaultArgTagKey,
 	}
 
 	for _, opt := range opts {
@@ -61,6 +79,13 @@ func UnmarshalOptions(c *Command, v interface{}, opts ...UnmarshalOptionsOption)
 			return fmt.Errorf("field=%s: tag=%s: %w", field.Name, cfg.tagKey, ErrStructFieldCannotBeSet)
 		}
 
+		if argName := stringz.TrimLeftSpace(field.Tag.Get(cfg.argTagKey)); argName != "" {
+			if err := unmarshalArg(c, fieldValue, argName); err != nil {
+				return fmt.Errorf("field=%s: tag=%s: %w", field.Name, cfg.argTagKey, err)
+			}
+			continue
+		}
+
 		tagValue := stringz.TrimLeftSpace(field.Tag.Get(cfg.tagKey))
 		Logger.Debug(fmt.Sprintf("tagKey=%s, tagValue=%s", cfg.tagKey, tagValue))
 		if tagValue == "" {
@@ -73,10 +98,20 @@ func UnmarshalOptions(c *Command, v interface{}, opts ...UnmarshalOptionsOption)
 			return fmt.Errorf("field=%s: tag=%s: tagValue=%s: %w", field.Name, cfg.tagKey, tagValue, ErrInvalidTagValue)
 		}
 
+		if ok, err := unmarshalTypedOption(c, fieldValue, optName); err != nil {
+			return fmt.Errorf("field=%s: tag=%s: %w", field.Name, cfg.tagKey, err)
+		} else if ok {
+			continue
+		}
+
 		//nolint:exhaustive
 		switch fieldValue.Kind() {
 		case reflect.String: // string
 			optValue, err := c.GetOptionString(optName)
+			if errors.Is(err, ErrUnknownOption) {
+				// NOTE: The string field with the enum tag is marshaled as EnumOption.
+				optValue, err = c.GetOptionEnum(optName)
+			}
 			if err != nil {
 				return fmt.Errorf("field=%s: tag=%s: cmd.GetOptionString: %w", field.Name, cfg.tagKey, err)
 			}
@@ -105,6 +140,10 @@ func UnmarshalOptions(c *Command, v interface{}, opts ...UnmarshalOptionsOption)
 				return fmt.Errorf("field=%s: tag=%s: cmd.GetOptionFloat64: %w", field.Name, cfg.tagKey, err)
 			}
 			fieldValue.SetFloat(optValue)
+		case reflect.Slice: // []string, []int64, []uint64, []float64, etc.
+			if err := unmarshalSliceOption(c, fieldValue, optName); err != nil {
+				return fmt.Errorf("field=%s: tag=%s: %T: %w", field.Name, cfg.tagKey, v, err)
+			}
 		default:
 			return fmt.Errorf("field=%s: tag=%s: %T: %w", field.Name, cfg.tagKey, v, ErrStructFieldTypeNotSupported)
 		}
@@ -113,6 +152,218 @@ func UnmarshalOptions(c *Command, v interface{}, opts ...UnmarshalOptionsOption)
 	return nil
 }
 
+// unmarshalTypedOption sets the value for the field types that are not determined by reflect.Kind only.
+//
+//nolint:cyclop
+func unmarshalTypedOption(c *Command, fieldValue reflect.Value, optName string) (ok bool, err error) {
+	if _, ok := newValueForType(fieldValue.Type()); ok { // the type that implements Value
+		optValue, err := c.GetOptionValue(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionValue: %w", err)
+		}
+		if err := setValueToField(fieldValue, optValue); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	switch fieldValue.Type() {
+	case durationType: // time.Duration
+		optValue, err := c.GetOptionDuration(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionDuration: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(optValue))
+	case timeType: // time.Time
+		optValue, err := c.GetOptionTime(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionTime: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(optValue))
+	case addrType: // netip.Addr
+		optValue, err := c.GetOptionIP(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionIP: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(optValue))
+	case prefixType: // netip.Prefix
+		optValue, err := c.GetOptionPrefix(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionPrefix: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(optValue))
+	case urlType: // *url.URL
+		optValue, err := c.GetOptionURL(optName)
+		if err != nil {
+			return false, fmt.Errorf("cmd.GetOptionURL: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(optValue))
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// setValueToField sets v to the field that implements Value.
+// If v is not the same type as the field, the field is set through (Value).Set with the string representation of v.
+func setValueToField(fieldValue reflect.Value, v Value) error {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type() == fieldValue.Type(): // the field is *T
+		fieldValue.Set(rv)
+		return nil
+	case rv.Kind() == reflect.Ptr && rv.Type().Elem() == fieldValue.Type(): // the field is T
+		fieldValue.Set(rv.Elem())
+		return nil
+	}
+
+	if fieldValue.Kind() == reflect.Ptr {
+		if fieldValue.IsNil() {
+			fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+		}
+		fieldValue = fieldValue.Elem()
+	}
+
+	//nolint:forcetypeassert // newValueForType guarantees that the pointer to the field implements Value.
+	if err := fieldValue.Addr().Interface().(Value).Set(v.String()); err != nil {
+		return fmt.Errorf("(Value).Set: %w", err)
+	}
+
+	return nil
+}
+
+//nolint:cyclop
+func unmarshalSliceOption(c *Command, fieldValue reflect.Value, optName string) error {
+	//nolint:exhaustive
+	switch fieldValue.Type().Elem().Kind() {
+	case reflect.String: // []string
+		optValue, err := c.GetOptionStringSlice(optName)
+		if err != nil {
+			return fmt.Errorf("cmd.GetOptionStringSlice: %w", err)
+		}
+		slice := reflect.MakeSlice(fieldValue.Type(), len(optValue), len(optValue))
+		for i, v := range optValue {
+			slice.Index(i).SetString(v)
+		}
+		fieldValue.Set(slice)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64: // []int, []int8, []int16, []int32, []int64
+		optValue, err := c.GetOptionInt64Slice(optName)
+		if err != nil {
+			return fmt.Errorf("cmd.GetOptionInt64Slice: %w", err)
+		}
+		slice := reflect.MakeSlice(fieldValue.Type(), len(optValue), len(optValue))
+		for i, v := range optValue {
+			if slice.Index(i).OverflowInt(v) {
+				return fmt.Errorf("index=%d: value=%d overflows %s: %w", i, v, fieldValue.Type().Elem(), ErrInvalidOptionValue)
+			}
+			slice.Index(i).SetInt(v)
+		}
+		fieldValue.Set(slice)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64: // []uint, []uint8, []uint16, []uint32, []uint64
+		optValue, err := c.GetOptionUint64Slice(optName)
+		if err != nil {
+			return fmt.Errorf("cmd.GetOptionUint64Slice: %w", err)
+		}
+		slice := reflect.MakeSlice(fieldValue.Type(), len(optValue), len(optValue))
+		for i, v := range optValue {
+			if slice.Index(i).OverflowUint(v) {
+				return fmt.Errorf("index=%d: value=%d overflows %s: %w", i, v, fieldValue.Type().Elem(), ErrInvalidOptionValue)
+			}
+			slice.Index(i).SetUint(v)
+		}
+		fieldValue.Set(slice)
+	case reflect.Float32, reflect.Float64: // []float32, []float64
+		optValue, err := c.GetOptionFloat64Slice(optName)
+		if err != nil {
+			return fmt.Errorf("cmd.GetOptionFloat64Slice: %w", err)
+		}
+		slice := reflect.MakeSlice(fieldValue.Type(), len(optValue), len(optValue))
+		for i, v := range optValue {
+			slice.Index(i).SetFloat(v)
+		}
+		fieldValue.Set(slice)
+	default:
+		return ErrStructFieldTypeNotSupported
+	}
+
+	return nil
+}
+
+func unmarshalArg(c *Command, fieldValue reflect.Value, argName string) error {
+	argValues, err := c.GetArgs(argName)
+	if err != nil {
+		return fmt.Errorf("cmd.GetArgs: %w", err)
+	}
+
+	if fieldValue.Kind() == reflect.Slice {
+		slice := reflect.MakeSlice(fieldValue.Type(), len(argValues), len(argValues))
+		for i, v := range argValues {
+			if err := setStringToField(slice.Index(i), v); err != nil {
+				return err
+			}
+		}
+		fieldValue.Set(slice)
+		return nil
+	}
+
+	if len(argValues) == 0 {
+		return nil
+	}
+
+	return setStringToField(fieldValue, argValues[0])
+}
+
+// setStringToField parses s by the type of the field and sets it.
+//
+//nolint:cyclop
+func setStringToField(fieldValue reflect.Value, s string) error {
+	if fieldValue.Type() == durationType {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("time.ParseDuration: %w", err)
+		}
+		fieldValue.SetInt(int64(v))
+		return nil
+	}
+
+	// NOTE: The errors of strconv are returned as they are, because strconv.NumError contains the function name.
+	//
+	//nolint:exhaustive
+	switch fieldValue.Kind() {
+	case reflect.String:
+		fieldValue.SetString(s)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		fieldValue.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		fieldValue.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, fieldValue.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		fieldValue.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, fieldValue.Type().Bits())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		fieldValue.SetFloat(v)
+	default:
+		return fmt.Errorf("%s: %w", fieldValue.Type(), ErrStructFieldTypeNotSupported)
+	}
+
+	return nil
+}
+
 func parseTagValue(tagValue string) (envKey string, opts []string) {
 	if i := strings.Index(tagValue, ","); i != -1 {
 		envKey = tagValue[:i]
@@ -2,7 +2,10 @@ package cliz
 
 import (
 	"context"
+	"net/netip"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hakadoriya/z.go/testingz/requirez"
 )
@@ -18,6 +21,29 @@ type (
 
 		TagNotSet string
 	}
+	testStructSlice struct {
+		Strings  []string  `cli:"strings-opt,alias=ss,env=ENVZ_TEST_STRINGS,default=a;b,description=strings description"`
+		Ints     []int     `cli:"ints-opt,default=1;2"`
+		Uints    []uint32  `cli:"uints-opt"`
+		Float64s []float64 `cli:"float64s-opt,hidden"`
+	}
+	testStructSliceOverflow struct {
+		Int8s  []int8  `cli:"int8s-opt"`
+		Uint8s []uint8 `cli:"uint8s-opt"`
+	}
+	testStructTyped struct {
+		Duration time.Duration `cli:"duration-opt,default=1s"`
+		Time     time.Time     `cli:"time-opt,layout=2006-01-02,default=2024-01-02"`
+		Addr     netip.Addr    `cli:"addr-opt,default=192.0.2.1"`
+		Prefix   netip.Prefix  `cli:"prefix-opt"`
+		URL      *url.URL      `cli:"url-opt,default=https://example.com"`
+		Enum     string        `cli:"enum-opt,enum=json;yaml,default=json"`
+	}
+	testStructValue struct {
+		Size    testByteSize  `cli:"size-opt,env=ENVZ_TEST_SIZE,default=1Ki"`
+		Limit   *testByteSize `cli:"limit-opt"`
+		Enabled testSwitch    `cli:"switch-opt"`
+	}
 	testStructCannotSet struct {
 		cannotSet string `cli:"string-opt"`
 	}
@@ -178,4 +204,80 @@ func TestCommand_Unmarshal(t *testing.T) {
 		err = UnmarshalOptions(c, &v)
 		requirez.ErrorIs(t, err, ErrStructFieldTypeNotSupported)
 	})
+	t.Run("success,slice", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructSlice
+		options, err := MarshalOptions(&v)
+		requirez.NoError(t, err)
+		c := &Command{Name: "main-cli", Options: options}
+		_, err = c.parse(context.Background(), []string{"main-cli", "--uints-opt=8,16", "--float64s-opt", "0.5", "--float64s-opt", "1.5"})
+		requirez.NoError(t, err)
+		err = UnmarshalOptions(c, &v)
+		requirez.NoError(t, err)
+		requirez.Equal(t, testStructSlice{Strings: []string{"a", "b"}, Ints: []int{1, 2}, Uints: []uint32{8, 16}, Float64s: []float64{0.5, 1.5}}, v)
+	})
+	t.Run("success,slice_uint8", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructSliceOverflow
+		options, err := MarshalOptions(&v)
+		requirez.NoError(t, err)
+		c := &Command{Name: "main-cli", Options: options}
+		_, err = c.parse(context.Background(), []string{"main-cli", "--int8s-opt=-128,127", "--uint8s-opt=0,255"})
+		requirez.NoError(t, err)
+		err = UnmarshalOptions(c, &v)
+		requirez.NoError(t, err)
+		requirez.Equal(t, testStructSliceOverflow{Int8s: []int8{-128, 127}, Uint8s: []uint8{0, 255}}, v)
+	})
+	t.Run("failure,slice_overflow", func(t *testing.T) {
+		t.Parallel()
+
+		for _, osArg := range []string{"--int8s-opt=1,128", "--int8s-opt=-129", "--uint8s-opt=256"} {
+			var v testStructSliceOverflow
+			options, err := MarshalOptions(&v)
+			requirez.NoError(t, err)
+			c := &Command{Name: "main-cli", Options: options}
+			_, err = c.parse(context.Background(), []string{"main-cli", osArg})
+			requirez.NoError(t, err)
+			err = UnmarshalOptions(c, &v)
+			requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+		}
+	})
+	t.Run("success,typed", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructTyped
+		options, err := MarshalOptions(&v)
+		requirez.NoError(t, err)
+		c := &Command{Name: "main-cli", Options: options}
+		_, err = c.parse(context.Background(), []string{"main-cli", "--prefix-opt=192.0.2.0/24", "--enum-opt=yaml"})
+		requirez.NoError(t, err)
+		err = UnmarshalOptions(c, &v)
+		requirez.NoError(t, err)
+		requirez.Equal(t, time.Second, v.Duration)
+		requirez.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), v.Time)
+		requirez.Equal(t, netip.MustParseAddr("192.0.2.1"), v.Addr)
+		requirez.Equal(t, netip.MustParsePrefix("192.0.2.0/24"), v.Prefix)
+		requirez.Equal(t, "https://example.com", v.URL.String())
+		requirez.Equal(t, "yaml", v.Enum)
+	})
+	t.Run("success,Value", func(t *testing.T) {
+		t.Parallel()
+
+		var v testStructValue
+		options, err := MarshalOptions(&v)
+		requirez.NoError(t, err)
+		requirez.Equal(t, "size-opt", options[0].(*ValueOption).Name)
+		requirez.Equal(t, "ENVZ_TEST_SIZE", options[0].(*ValueOption).Env)
+		requirez.Equal(t, "1Ki", options[0].(*ValueOption).Default)
+		c := &Command{Name: "main-cli", Options: options}
+		_, err = c.parse(context.Background(), []string{"main-cli", "--limit-opt=1Mi", "--switch-opt"})
+		requirez.NoError(t, err)
+		err = UnmarshalOptions(c, &v)
+		requirez.NoError(t, err)
+		requirez.Equal(t, testByteSize(1024), v.Size)
+		requirez.Equal(t, testByteSize(1<<20), *v.Limit)
+		requirez.Equal(t, testSwitch("on"), v.Enabled)
+	})
 }
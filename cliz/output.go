@@ -0,0 +1,215 @@
+package cliz
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/hakadoriya/z.go/encodingz/csvz"
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// OutputFormat is the format of the output written by (*Command).Render.
+	OutputFormat string
+
+	// Output is the output format option of the command, like `--output=json`.
+	//
+	// The option is added to PersistentOptions of the command, so it is accepted by all the subcommands,
+	// and (*Command).Render of the subcommands writes the value in the format specified by it.
+	Output struct {
+		// OptionName is the name of the option to specify the output format.
+		//
+		// If OptionName is empty, DefaultOutputOptionName is used.
+		OptionName string
+		// Aliases is the alias names of the option to specify the output format.
+		Aliases []string
+		// Env is the environment variable name of the option to specify the output format.
+		Env string
+		// Default is the default output format.
+		//
+		// If Default is empty, DefaultOutputFormat is used.
+		Default OutputFormat
+	}
+)
+
+const (
+	// OutputFormatJSON is the indented JSON.
+	OutputFormatJSON OutputFormat = "json"
+	// OutputFormatYAML is the YAML converted from the JSON representation of the value.
+	OutputFormatYAML OutputFormat = "yaml"
+	// OutputFormatTable is the aligned table of the struct or the slice of structs.
+	// The column names are the `table` struct tag values, or the field names if the tag is not set.
+	// The fields tagged with `table:"-"` are omitted.
+	OutputFormatTable OutputFormat = "table"
+	// OutputFormatCSV is the CSV of the struct or the slice of structs, encoded by csvz.CSVEncoder with `csv` struct tags.
+	OutputFormatCSV OutputFormat = "csv"
+	// OutputFormatTemplate is the Go text/template, specified like `--output='template={{ .Name }}'`.
+	OutputFormatTemplate OutputFormat = "template"
+
+	// outputFormatTemplatePrefix is the prefix of the output format option value of OutputFormatTemplate.
+	outputFormatTemplatePrefix = string(OutputFormatTemplate) + "="
+)
+
+//nolint:gochecknoglobals
+var outputFormats = []OutputFormat{OutputFormatJSON, OutputFormatYAML, OutputFormatTable, OutputFormatCSV, OutputFormatTemplate}
+
+func (o *Output) getOptionName() string {
+	if o.OptionName != "" {
+		return o.OptionName
+	}
+	return DefaultOutputOptionName
+}
+
+func (o *Output) getDefault() OutputFormat {
+	if o.Default != "" {
+		return o.Default
+	}
+	return DefaultOutputFormat
+}
+
+// parseOutputFormat parses the output format option value like `json` or `template={{ .Name }}`.
+func parseOutputFormat(s string) (format OutputFormat, tmpl string, err error) {
+	if strings.HasPrefix(s, outputFormatTemplatePrefix) {
+		return OutputFormatTemplate, strings.TrimPrefix(s, outputFormatTemplatePrefix), nil
+	}
+
+	switch format := OutputFormat(s); format {
+	case OutputFormatJSON, OutputFormatYAML, OutputFormatTable, OutputFormatCSV:
+		return format, "", nil
+	case OutputFormatTemplate:
+		return "", "", errorz.Errorf("%s: template is required like `%s{{ . }}`: %w", s, outputFormatTemplatePrefix, ErrUnsupportedOutputFormat)
+	default:
+		return "", "", errorz.Errorf("%s: %w", s, ErrUnsupportedOutputFormat)
+	}
+}
+
+func (c *Command) initAppendOutputOption() {
+	if c.Output == nil {
+		return
+	}
+
+	// If output option is already set, do nothing.
+	if _, ok := c.getOutputOption(); ok {
+		return
+	}
+
+	formats := make([]string, 0, len(outputFormats))
+	for _, format := range outputFormats {
+		formats = append(formats, string(format))
+	}
+
+	//nolint:exhaustruct
+	c.PersistentOptions = append(c.PersistentOptions, &StringOption{
+		Name:        c.Output.getOptionName(),
+		Aliases:     c.Output.Aliases,
+		Env:         c.Output.Env,
+		Default:     string(c.Output.getDefault()),
+		Description: "output format (" + strings.Join(formats, ", ") + "=<text>)",
+		CompleteFunc: func(_ *Command, _ string) []string {
+			candidates := make([]string, 0, len(outputFormats))
+			for _, format := range outputFormats {
+				if format == OutputFormatTemplate {
+					candidates = append(candidates, outputFormatTemplatePrefix)
+					continue
+				}
+				candidates = append(candidates, string(format))
+			}
+			return candidates
+		},
+		Validate: func(value string) error {
+			_, _, err := parseOutputFormat(value)
+			return err
+		},
+	})
+}
+
+func (c *Command) getOutputOption() (outputOption *StringOption, ok bool) {
+	if c.Output == nil {
+		return nil, false
+	}
+
+	for _, opt := range c.PersistentOptions {
+		if o, ok := opt.(*StringOption); ok && o.Name == c.Output.getOptionName() {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// getOutputFormat returns the output format specified by the output option of the command or the nearest ancestor,
+// or DefaultOutputFormat if no output option exists.
+func (c *Command) getOutputFormat() (format OutputFormat, tmpl string, err error) {
+	for p := c; p != nil; p = p.parent {
+		o, ok := p.getOutputOption()
+		if !ok {
+			continue
+		}
+		if o.value == nil {
+			return p.Output.getDefault(), "", nil
+		}
+		return parseOutputFormat(*o.value)
+	}
+
+	return DefaultOutputFormat, "", nil
+}
+
+// Render writes v to Stdout() in the output format specified by the option of Output.
+//
+// For OutputFormatTable and OutputFormatCSV, v must be a struct or a slice of structs.
+func (c *Command) Render(v interface{}) error {
+	format, tmpl, err := c.getOutputFormat()
+	if err != nil {
+		return errorz.Errorf("cmd = %s: %w", strings.Join(c.allExecutedCommandNames, " "), err)
+	}
+
+	switch format {
+	case OutputFormatJSON:
+		enc := json.NewEncoder(c.Stdout())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			return errorz.Errorf("json.Encoder.Encode: %w", err)
+		}
+	case OutputFormatYAML:
+		if err := renderYAML(c.Stdout(), v); err != nil {
+			return errorz.Errorf("renderYAML: %w", err)
+		}
+	case OutputFormatTable:
+		if err := renderTable(c.Stdout(), v); err != nil {
+			return errorz.Errorf("renderTable: %w", err)
+		}
+	case OutputFormatCSV:
+		if err := csvz.NewCSVEncoder(c.Stdout()).Encode(toStructSlice(v)); err != nil {
+			return errorz.Errorf("csvz.CSVEncoder.Encode: %w", err)
+		}
+	case OutputFormatTemplate:
+		t, err := template.New(string(OutputFormatTemplate)).Parse(tmpl)
+		if err != nil {
+			return errorz.Errorf("template.Parse: %w", err)
+		}
+		if err := t.Execute(c.Stdout(), v); err != nil {
+			return errorz.Errorf("template.Execute: %w", err)
+		}
+	default:
+		return errorz.Errorf("%s: %w", format, ErrUnsupportedOutputFormat)
+	}
+
+	return nil
+}
+
+// toStructSlice wraps v into the slice if v is a struct or a pointer to a struct, otherwise returns v as it is.
+func toStructSlice(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	elem := rv
+	if elem.Kind() == reflect.Pointer && !elem.IsNil() {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return v
+	}
+
+	slice := reflect.MakeSlice(reflect.SliceOf(rv.Type()), 0, 1)
+	return reflect.Append(slice, rv).Interface()
+}
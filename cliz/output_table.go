@@ -0,0 +1,123 @@
+package cliz
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// tableColumn is the column of the table, which is the exported field of the struct.
+type tableColumn struct {
+	header string
+	index  int
+}
+
+// renderTable writes v, which is a struct or a slice of structs, as the aligned table.
+func renderTable(w io.Writer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	rows := make([]reflect.Value, 0)
+	var elemType reflect.Type
+	//nolint:exhaustive
+	switch rv.Kind() {
+	case reflect.Struct:
+		elemType = rv.Type()
+		rows = append(rows, rv)
+	case reflect.Slice, reflect.Array:
+		elemType = rv.Type().Elem()
+		for elemType.Kind() == reflect.Pointer {
+			elemType = elemType.Elem()
+		}
+		for i := range rv.Len() {
+			rows = append(rows, rv.Index(i))
+		}
+	}
+	if elemType == nil || elemType.Kind() != reflect.Struct {
+		return errorz.Errorf("%T: must be a struct or a slice of structs: %w", v, ErrUnsupportedOutputValue)
+	}
+
+	columns := tableColumns(elemType)
+
+	const padding = 2
+	buf := new(bytes.Buffer)
+	tw := tabwriter.NewWriter(buf, 0, 0, padding, ' ', 0)
+
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.header)
+	}
+	_, _ = fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, row := range rows {
+		for row.Kind() == reflect.Pointer && !row.IsNil() {
+			row = row.Elem()
+		}
+		cells := make([]string, 0, len(columns))
+		for _, column := range columns {
+			if row.Kind() != reflect.Struct {
+				// NOTE: nil pointer element is rendered as the empty row.
+				cells = append(cells, "")
+				continue
+			}
+			cells = append(cells, tableCell(row.Field(column.index)))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return errorz.Errorf("tabwriter.Writer.Flush: %w", err)
+	}
+
+	// NOTE: the empty trailing cells are padded by tabwriter, so trim the trailing spaces.
+	for _, line := range strings.SplitAfter(buf.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		if _, err := io.WriteString(w, strings.TrimRight(line, " \n")+"\n"); err != nil {
+			return errorz.Errorf("io.WriteString: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func tableColumns(t reflect.Type) []tableColumn {
+	columns := make([]tableColumn, 0, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		header := field.Tag.Get(DefaultTableTagKey)
+		switch header {
+		case "-":
+			continue
+		case "":
+			header = field.Name
+		}
+
+		columns = append(columns, tableColumn{header: header, index: i})
+	}
+	return columns
+}
+
+func tableCell(field reflect.Value) string {
+	for field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+
+	// NOTE: tab and newline break the alignment of the table.
+	return strings.NewReplacer("\t", " ", "\n", " ").Replace(fmt.Sprint(field.Interface()))
+}
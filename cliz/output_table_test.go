@@ -0,0 +1,78 @@
+package cliz
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestRenderTable(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID      int
+		Name    string     `table:"NAME"`
+		Comment *string    `table:"COMMENT"`
+		Created *time.Time `table:"CREATED"`
+		Secret  string     `table:"-"`
+		private string
+	}
+
+	comment := "multi\nline\tcomment"
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			v        interface{}
+			expected string
+		}{
+			{
+				name: "slice",
+				v: []*item{
+					{ID: 1, Name: "foo", Comment: &comment, Created: &created, Secret: "secret", private: "private"},
+					nil,
+					{ID: 100, Name: "bar"},
+				},
+				expected: "" +
+					"ID   NAME  COMMENT             CREATED\n" +
+					"1    foo   multi line comment  2024-01-02 03:04:05 +0000 UTC\n" +
+					"\n" +
+					"100  bar\n",
+			},
+			{
+				name:     "struct",
+				v:        &item{ID: 1, Name: "foo"},
+				expected: "ID  NAME  COMMENT  CREATED\n1   foo\n",
+			},
+			{
+				name:     "empty",
+				v:        [0]item{},
+				expected: "ID  NAME  COMMENT  CREATED\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				buf := new(bytes.Buffer)
+				requirez.NoError(t, renderTable(buf, tt.v))
+				assertz.Equal(t, tt.expected, buf.String())
+			})
+		}
+	})
+
+	t.Run("error,ErrUnsupportedOutputValue", func(t *testing.T) {
+		t.Parallel()
+
+		for _, v := range []interface{}{nil, "foo", []string{"foo"}, map[string]item{}} {
+			requirez.ErrorIs(t, renderTable(new(bytes.Buffer), v), ErrUnsupportedOutputValue)
+		}
+	})
+}
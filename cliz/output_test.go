@@ -0,0 +1,143 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+type testOutputItem struct {
+	Name  string `json:"name"  csv:"name"  table:"NAME"`
+	Count int    `json:"count" csv:"count" table:"COUNT"`
+	Note  string `json:"-"     csv:"-"     table:"-"`
+}
+
+func newTestOutputCommand(output *Output) *Command {
+	return &Command{
+		Name:   "main-cli",
+		Output: output,
+		SubCommands: []*Command{
+			{Name: "sub-cmd"},
+		},
+	}
+}
+
+func TestCommand_Render(t *testing.T) {
+	t.Parallel()
+
+	items := []testOutputItem{{Name: "foo", Count: 1, Note: "x"}, {Name: "bar-baz", Count: 10}}
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			output   *Output
+			osArgs   []string
+			v        interface{}
+			expected string
+		}{
+			{
+				name:     "default",
+				output:   &Output{},
+				osArgs:   []string{"main-cli", "sub-cmd"},
+				v:        items,
+				expected: "NAME     COUNT\nfoo      1\nbar-baz  10\n",
+			},
+			{
+				name:     "Default",
+				output:   &Output{Default: OutputFormatJSON},
+				osArgs:   []string{"main-cli", "sub-cmd"},
+				v:        items[0],
+				expected: "{\n  \"name\": \"foo\",\n  \"count\": 1\n}\n",
+			},
+			{
+				name:     "json",
+				output:   &Output{Aliases: []string{"o"}},
+				osArgs:   []string{"main-cli", "sub-cmd", "-o", "json"},
+				v:        items[:1],
+				expected: "[\n  {\n    \"name\": \"foo\",\n    \"count\": 1\n  }\n]\n",
+			},
+			{
+				name:     "yaml",
+				output:   &Output{},
+				osArgs:   []string{"main-cli", "--output=yaml", "sub-cmd"},
+				v:        items,
+				expected: "- name: foo\n  count: 1\n- name: bar-baz\n  count: 10\n",
+			},
+			{
+				name:     "csv",
+				output:   &Output{},
+				osArgs:   []string{"main-cli", "sub-cmd", "--output=csv"},
+				v:        &items[1],
+				expected: "name,count\nbar-baz,10\n",
+			},
+			{
+				name:     "template",
+				output:   &Output{OptionName: "format"},
+				osArgs:   []string{"main-cli", "sub-cmd", "--format", "template={{ range . }}{{ .Name }}={{ .Count }}\n{{ end }}"},
+				v:        items,
+				expected: "foo=1\nbar-baz=10\n",
+			},
+			{
+				name:     "no_Output",
+				output:   nil,
+				osArgs:   []string{"main-cli", "sub-cmd"},
+				v:        items[0],
+				expected: "NAME  COUNT\nfoo   1\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestOutputCommand(tt.output)
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+
+				buf := new(bytes.Buffer)
+				subcmd := c.SubCommands[0]
+				subcmd.SetStdout(buf)
+				requirez.NoError(t, subcmd.Render(tt.v))
+				assertz.Equal(t, tt.expected, buf.String())
+			})
+		}
+	})
+
+	t.Run("error,ErrUnsupportedOutputFormat", func(t *testing.T) {
+		t.Parallel()
+
+		for _, osArg := range []string{"--output=xml", "--output=template"} {
+			c := newTestOutputCommand(&Output{})
+			_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", osArg})
+			requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+			requirez.ErrorIs(t, err, ErrUnsupportedOutputFormat)
+		}
+	})
+
+	t.Run("error,ErrUnsupportedOutputValue", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestOutputCommand(&Output{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd"})
+		requirez.NoError(t, err)
+
+		c.SubCommands[0].SetStdout(new(bytes.Buffer))
+		requirez.ErrorIs(t, c.SubCommands[0].Render("string"), ErrUnsupportedOutputValue)
+	})
+
+	t.Run("error,template", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestOutputCommand(&Output{})
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "--output=template={{ .Unknown }}"})
+		requirez.NoError(t, err)
+
+		c.SubCommands[0].SetStdout(new(bytes.Buffer))
+		requirez.ErrorContains(t, c.SubCommands[0].Render(items[0]), "template.Execute")
+	})
+}
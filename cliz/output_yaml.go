@@ -0,0 +1,160 @@
+package cliz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// yamlMapItem is the key-value pair of the mapping, which keeps the order of the JSON object keys.
+type yamlMapItem struct {
+	key   string
+	value interface{}
+}
+
+const yamlIndent = "  "
+
+// renderYAML writes v as YAML.
+//
+// v is converted to JSON first, so the `json` struct tags and json.Marshaler are respected,
+// and the order of the struct fields is kept.
+func renderYAML(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errorz.Errorf("json.Marshal: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	value, err := decodeOrderedJSON(dec)
+	if err != nil {
+		return errorz.Errorf("decodeOrderedJSON: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	writeYAMLValue(buf, value, "")
+	if _, err := buf.WriteTo(w); err != nil {
+		return errorz.Errorf("bytes.Buffer.WriteTo: %w", err)
+	}
+
+	return nil
+}
+
+// decodeOrderedJSON decodes the JSON value, decoding the objects to []yamlMapItem instead of map.
+func decodeOrderedJSON(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, errorz.Errorf("json.Decoder.Token: %w", err)
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		items := make([]yamlMapItem, 0)
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, errorz.Errorf("json.Decoder.Token: %w", err)
+			}
+			key, _ := keyTok.(string)
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, yamlMapItem{key: key, value: value})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, errorz.Errorf("json.Decoder.Token: %w", err)
+		}
+		return items, nil
+	case '[':
+		values := make([]interface{}, 0)
+		for dec.More() {
+			value, err := decodeOrderedJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, errorz.Errorf("json.Decoder.Token: %w", err)
+		}
+		return values, nil
+	default:
+		return nil, errorz.Errorf("unexpected delimiter %s: %w", delim, ErrUnsupportedOutputValue)
+	}
+}
+
+// writeYAMLValue writes the block of value, each line of which starts with indent.
+func writeYAMLValue(buf *bytes.Buffer, value interface{}, indent string) {
+	switch v := value.(type) {
+	case []yamlMapItem:
+		if len(v) == 0 {
+			buf.WriteString(indent + "{}\n")
+			return
+		}
+		for _, item := range v {
+			buf.WriteString(indent + yamlScalar(item.key) + ":")
+			writeYAMLNested(buf, item.value, indent+yamlIndent)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(indent + "[]\n")
+			return
+		}
+		for _, item := range v {
+			nested := new(bytes.Buffer)
+			writeYAMLValue(nested, item, indent+yamlIndent)
+			// NOTE: the first line of the nested block is written after "- ", like `- key: value`.
+			buf.WriteString(indent + "- " + strings.TrimPrefix(nested.String(), indent+yamlIndent))
+		}
+	default:
+		buf.WriteString(indent + yamlScalar(v) + "\n")
+	}
+}
+
+// writeYAMLNested writes value after the mapping key.
+func writeYAMLNested(buf *bytes.Buffer, value interface{}, indent string) {
+	switch v := value.(type) {
+	case []yamlMapItem:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+	default:
+		buf.WriteString(" " + yamlScalar(v) + "\n")
+		return
+	}
+
+	buf.WriteString("\n")
+	writeYAMLValue(buf, value, indent)
+}
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		return quoteYAMLScalar(v)
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
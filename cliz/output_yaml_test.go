@@ -0,0 +1,119 @@
+package cliz
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func TestRenderYAML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		type nested struct {
+			Tags   []string          `json:"tags"`
+			Labels map[string]string `json:"labels"`
+			Empty  []string          `json:"empty"`
+			Ptr    *string           `json:"ptr"`
+		}
+		type item struct {
+			Name    string   `json:"name"`
+			Enabled bool     `json:"enabled"`
+			Ratio   float64  `json:"ratio"`
+			Nested  nested   `json:"nested"`
+			Items   []nested `json:"items"`
+			Matrix  [][]int  `json:"matrix"`
+		}
+
+		tests := []struct {
+			name     string
+			v        interface{}
+			expected string
+		}{
+			{name: "scalar", v: "foo", expected: "foo\n"},
+			{name: "empty_slice", v: []string{}, expected: "[]\n"},
+			{name: "empty_map", v: map[string]string{}, expected: "{}\n"},
+			{
+				name: "struct",
+				v: item{
+					Name:    "foo",
+					Enabled: true,
+					Ratio:   0.5,
+					Nested:  nested{Tags: []string{"a", "b"}, Labels: map[string]string{"k": "v"}, Empty: []string{}},
+					Items:   []nested{{Tags: []string{"c"}}},
+					Matrix:  [][]int{{1, 2}, {3}},
+				},
+				expected: `name: foo
+enabled: true
+ratio: 0.5
+nested:
+  tags:
+    - a
+    - b
+  labels:
+    k: v
+  empty: []
+  ptr: null
+items:
+  - tags:
+      - c
+    labels: null
+    empty: null
+    ptr: null
+matrix:
+  - - 1
+    - 2
+  - - 3
+`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				buf := new(bytes.Buffer)
+				requirez.NoError(t, renderYAML(buf, tt.v))
+				assertz.Equal(t, tt.expected, buf.String())
+			})
+		}
+	})
+
+	t.Run("error,json.Marshal", func(t *testing.T) {
+		t.Parallel()
+
+		requirez.ErrorContains(t, renderYAML(new(bytes.Buffer), func() {}), "json.Marshal")
+	})
+}
+
+func TestYAMLScalar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			s        string
+			expected string
+		}{
+			{s: "foo bar", expected: `foo bar`},
+			{s: "", expected: `""`},
+			{s: " foo", expected: `" foo"`},
+			{s: "true", expected: `"true"`},
+			{s: "No", expected: `"No"`},
+			{s: "1.5", expected: `"1.5"`},
+			{s: "- foo", expected: `"- foo"`},
+			{s: "key: value", expected: `"key: value"`},
+			{s: "foo #bar", expected: `"foo #bar"`},
+			{s: "line1\nline2", expected: `"line1\nline2"`},
+		}
+
+		for _, tt := range tests {
+			assertz.Equal(t, tt.expected, yamlScalar(tt.s))
+		}
+	})
+}
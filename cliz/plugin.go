@@ -0,0 +1,202 @@
+package cliz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// Plugins is the plugin discovery of the external executables, like `git foo` runs `git-foo` on PATH.
+	//
+	// If the first argument of the root command is not a subcommand and the executable `<Prefix><name>` is found,
+	// the executable is run with the rest of the arguments, and the environment variables and stdio are forwarded.
+	Plugins struct {
+		// Prefix is the prefix of the executable names of the plugins.
+		//
+		// If Prefix is empty, the root command name and "-" are used, like `main-cli-`.
+		Prefix string
+		// Dirs is the directories to search the plugins in the order.
+		//
+		// If Dirs is nil, the directories in the PATH environment variable are used.
+		Dirs []string
+		// WaitDelay is the delay to kill the plugin after it is interrupted by the context cancellation.
+		//
+		// If WaitDelay is 0, DefaultPluginWaitDelay is used.
+		WaitDelay time.Duration
+	}
+
+	// plugin is the discovered plugin executable.
+	plugin struct {
+		// name is the subcommand name of the plugin, which is the executable name without the prefix.
+		name string
+		// path is the path of the executable.
+		path string
+	}
+
+	// PluginExitError is the error returned by (*Command).Exec when the plugin exits with the non-zero status.
+	PluginExitError struct {
+		// Plugin is the path of the plugin executable.
+		Plugin string
+		// Code is the exit code of the plugin.
+		Code int
+		// Err is the underlying error.
+		Err error
+	}
+)
+
+// DefaultPluginWaitDelay is the default of Plugins.WaitDelay.
+const DefaultPluginWaitDelay = 10 * time.Second
+
+var _ ExitCoder = (*PluginExitError)(nil)
+
+func (e *PluginExitError) Error() string {
+	return fmt.Sprintf("plugin %s: exit code %d", e.Plugin, e.Code)
+}
+
+func (e *PluginExitError) Unwrap() error { return e.Err }
+
+// ExitCode returns the exit code of the plugin, so that the caller can exit with the same code.
+func (e *PluginExitError) ExitCode() int { return e.Code }
+
+func (c *Command) getPluginPrefix() string {
+	if c.Plugins.Prefix != "" {
+		return c.Plugins.Prefix
+	}
+	return c.Name + "-"
+}
+
+func (c *Command) getPluginDirs() []string {
+	if c.Plugins.Dirs != nil {
+		return c.Plugins.Dirs
+	}
+	return filepath.SplitList(c.Getenv("PATH"))
+}
+
+// discoverPlugins returns the plugins sorted by the name.
+// If the same name is found in multiple directories, the first one is used like PATH.
+// The plugins which have the same name as the subcommands are ignored.
+func (c *Command) discoverPlugins() []*plugin {
+	if c.Plugins == nil {
+		return nil
+	}
+
+	prefix := c.getPluginPrefix()
+	plugins := make([]*plugin, 0)
+	found := make(map[string]bool)
+	for _, dir := range c.getPluginDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			Logger.Debug("discoverPlugins: os.ReadDir: " + err.Error())
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(entry.Name(), prefix)
+			if !ok || name == "" {
+				continue
+			}
+			name = strings.TrimSuffix(name, ".exe")
+			if found[name] || c.getSubcommand(name) != nil {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutableFile(path) {
+				continue
+			}
+
+			found[name] = true
+			plugins = append(plugins, &plugin{name: name, path: path})
+		}
+	}
+
+	slices.SortFunc(plugins, func(a, b *plugin) int { return strings.Compare(a.name, b.name) })
+	return plugins
+}
+
+// getPlugin returns the plugin of name, or nil if not found.
+// Unlike discoverPlugins, it does not read the directories, because it is called for each argument and completion word.
+func (c *Command) getPlugin(name string) *plugin {
+	if c.Plugins == nil || name == "" || strings.ContainsAny(name, `/\`) || c.getSubcommand(name) != nil {
+		return nil
+	}
+
+	filename := c.getPluginPrefix() + name
+	for _, dir := range c.getPluginDirs() {
+		for _, path := range []string{filepath.Join(dir, filename), filepath.Join(dir, filename+".exe")} {
+			if isExecutableFile(path) {
+				return &plugin{name: name, path: path}
+			}
+		}
+	}
+	return nil
+}
+
+func isExecutableFile(path string) bool {
+	stat, err := os.Stat(path)
+	return err == nil && stat.Mode().IsRegular() && stat.Mode().Perm()&0o111 != 0
+}
+
+// pluginEnviron returns the environment variables for the plugin, which are looked up by (*Command).Getenv,
+// so that the plugin sees the same environment variables as the command.
+// The keys are the ones of os.Environ and the environment variables of the options, and the empty values are omitted.
+func (c *Command) pluginEnviron() []string {
+	keys := make([]string, 0)
+	for _, kv := range os.Environ() {
+		if key, _, _ := strings.Cut(kv, "="); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	for _, opt := range c.getAcceptedOptions() {
+		if opt.GetEnv() != "" {
+			keys = append(keys, opt.GetEnv())
+		}
+	}
+
+	environ := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if v := c.Getenv(key); v != "" {
+			environ = append(environ, key+"="+v)
+		}
+	}
+	return environ
+}
+
+// execPlugin runs the plugin with args, forwarding the environment variables and stdio.
+// When ctx is canceled, the plugin is interrupted, and is killed after Plugins.WaitDelay.
+func (c *Command) execPlugin(ctx context.Context, args []string) error {
+	cmd := exec.CommandContext(ctx, c.plugin.path, args...)
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = DefaultPluginWaitDelay
+	if c.Plugins != nil && c.Plugins.WaitDelay > 0 {
+		cmd.WaitDelay = c.Plugins.WaitDelay
+	}
+	cmd.Env = c.pluginEnviron()
+	cmd.Stdin = c.Stdin()
+	cmd.Stdout = c.Stdout()
+	cmd.Stderr = c.Stderr()
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return &PluginExitError{Plugin: c.plugin.path, Code: exitErr.ExitCode(), Err: err}
+		}
+		return errorz.Errorf("exec.Cmd.Run: %w", err)
+	}
+
+	return nil
+}
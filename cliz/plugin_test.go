@@ -0,0 +1,203 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func writeTestPlugin(t *testing.T, dir, name, script string, perm os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	requirez.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), perm))
+	return path
+}
+
+func newTestPluginCommand(dirs ...string) *Command {
+	return &Command{
+		Name:    "main-cli",
+		Plugins: &Plugins{Dirs: dirs},
+		Options: []Option{
+			&BoolOption{Name: "verbose"},
+			&StringOption{Name: "required", Required: true},
+		},
+		SubCommands: []*Command{
+			{Name: "sub-cmd", Description: "run sub-cmd", ExecFunc: func(_ *Command, _ []string) error { return nil }},
+		},
+	}
+}
+
+func TestCommand_Plugins(t *testing.T) {
+	t.Parallel()
+
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	fooPath := writeTestPlugin(t, dir1, "main-cli-foo", `echo "foo $*"; echo "env $HOME" >&2; exit 0`, 0o755)
+	_ = writeTestPlugin(t, dir2, "main-cli-foo", `echo "shadowed"`, 0o755)
+	barPath := writeTestPlugin(t, dir2, "main-cli-bar", `exit 3`, 0o755)
+	_ = writeTestPlugin(t, dir1, "main-cli-sub-cmd", `echo "sub-cmd plugin"`, 0o755)
+	_ = writeTestPlugin(t, dir1, "main-cli-not-executable", `echo "not executable"`, 0o644)
+	_ = writeTestPlugin(t, dir1, "other-cli-baz", `echo "baz"`, 0o755)
+
+	t.Run("success,discoverPlugins", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2, filepath.Join(dir1, "not-found"))
+		plugins := c.discoverPlugins()
+		requirez.Equal(t, 2, len(plugins))
+		assertz.Equal(t, plugin{name: "bar", path: barPath}, *plugins[0])
+		assertz.Equal(t, plugin{name: "foo", path: fooPath}, *plugins[1])
+	})
+
+	t.Run("success,Exec", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		stdout := new(bytes.Buffer)
+		stderr := new(bytes.Buffer)
+		c.SetStdout(stdout)
+		c.SetStderr(stderr)
+		err := c.Exec(context.Background(), []string{"main-cli", "--verbose", "foo", "--help", "arg"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "foo --help arg\n", stdout.String())
+		assertz.Equal(t, "env "+os.Getenv("HOME")+"\n", stderr.String())
+
+		verbose, err := c.GetOptionBool("verbose")
+		requirez.NoError(t, err)
+		assertz.True(t, verbose)
+	})
+
+	t.Run("success,getPlugin", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		assertz.Equal(t, plugin{name: "foo", path: fooPath}, *c.getPlugin("foo"))
+		assertz.Equal(t, plugin{name: "bar", path: barPath}, *c.getPlugin("bar"))
+		assertz.Nil(t, c.getPlugin("sub-cmd"))
+		assertz.Nil(t, c.getPlugin("not-executable"))
+		assertz.Nil(t, c.getPlugin("baz"))
+		assertz.Nil(t, c.getPlugin("../main-cli-foo"))
+		assertz.Nil(t, c.getPlugin(""))
+	})
+
+	t.Run("success,Getenv", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		c.SetGetenv(func(key string) string {
+			if key == "HOME" {
+				return "/injected/home"
+			}
+			return os.Getenv(key)
+		})
+		stderr := new(bytes.Buffer)
+		c.SetStderr(stderr)
+		err := c.Exec(context.Background(), []string{"main-cli", "foo"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "env /injected/home\n", stderr.String())
+	})
+
+	t.Run("error,PluginExitError,interrupted", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		_ = writeTestPlugin(t, dir, "main-cli-wait", `trap 'echo interrupted; exit 130' INT; echo started; while :; do sleep 0.01; done`, 0o755)
+
+		c := newTestPluginCommand(dir)
+		r, w := io.Pipe()
+		c.SetStdout(w)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			buf := make([]byte, len("started\n"))
+			_, _ = io.ReadFull(r, buf)
+			cancel()
+			_, _ = io.Copy(io.Discard, r)
+		}()
+		err := c.Exec(ctx, []string{"main-cli", "wait"})
+		_ = w.Close()
+		var exitErr *PluginExitError
+		requirez.True(t, errors.As(err, &exitErr))
+		assertz.Equal(t, 130, exitErr.ExitCode())
+	})
+
+	t.Run("error,PluginExitError", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		err := c.Exec(context.Background(), []string{"main-cli", "bar"})
+		var exitErr *PluginExitError
+		requirez.True(t, errors.As(err, &exitErr))
+		assertz.Equal(t, 3, exitErr.ExitCode())
+		assertz.Equal(t, barPath, exitErr.Plugin)
+	})
+
+	t.Run("success,subcommand", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		stdout := new(bytes.Buffer)
+		c.SetStdoutRecursive(stdout)
+		err := c.Exec(context.Background(), []string{"main-cli", "--required=x", "sub-cmd"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, "", stdout.String())
+	})
+
+	t.Run("error,not_plugin", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		_, err := c.parse(context.Background(), []string{"main-cli", "baz"})
+		requirez.ErrorIs(t, err, ErrOptionRequired)
+	})
+
+	t.Run("success,help", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		c.Options = nil
+		buf := new(bytes.Buffer)
+		c.SetStderr(buf)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--help"})
+		requirez.ErrorIs(t, err, ErrHelp)
+		expected := `Usage:
+    main-cli [options] <subcommand>
+
+Sub Commands:
+    sub-cmd    run sub-cmd
+
+Plugins:
+    bar    ` + barPath + `
+    foo    ` + fooPath + `
+
+Options:
+    --help (default: false)
+        show help message and exit
+`
+		assertz.Equal(t, expected, buf.String())
+	})
+
+	t.Run("success,completion", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestPluginCommand(dir1, dir2)
+		_, err := c.parse(context.Background(), []string{"main-cli", "--required=x", "sub-cmd"})
+		requirez.NoError(t, err)
+
+		values := make([]string, 0)
+		for _, candidate := range c.getCompletionCandidatesForWords([]string{""}) {
+			values = append(values, candidate.value)
+		}
+		assertz.Equal(t, []string{"sub-cmd", "bar", "foo", "--verbose", "--required", "--help"}, values)
+
+		assertz.Equal(t, 0, len(c.getCompletionCandidatesForWords([]string{"foo", ""})))
+	})
+}
@@ -10,6 +10,16 @@ func (c *Command) postCheckOptions() error {
 		return errorz.Errorf("%s: %w", c.Name, err)
 	}
 
+	// NOTE: Validate functions
+	if err := c.postCheckOptionValidate(); err != nil {
+		return errorz.Errorf("%s: %w", c.Name, err)
+	}
+
+	// NOTE: constraint groups
+	if err := c.postCheckConstraintGroups(); err != nil {
+		return errorz.Errorf("%s: %w", c.Name, err)
+	}
+
 	return nil
 }
 
@@ -18,6 +28,12 @@ func (c *Command) postCheckOptionRequired() error {
 		for _, opt := range c.Options {
 			name := opt.GetName()
 			if opt.IsRequired() && opt.IsZero() {
+				if c.canPrompt() {
+					if err := c.promptOptionValue(opt); err != nil {
+						return errorz.Errorf("%s: %w", c.Name, err)
+					}
+					continue
+				}
 				return errorz.Errorf("%s: option: %s%s: %w", c.Name, longOptionPrefix, name, ErrOptionRequired)
 			}
 		}
@@ -11,6 +11,11 @@ func (c *Command) preCheckOptions() error {
 		return errorz.Errorf("%s: %w", c.Name, err)
 	}
 
+	// NOTE: constraint groups
+	if err := c.preCheckConstraintGroups(); err != nil {
+		return errorz.Errorf("%s: %w", c.Name, err)
+	}
+
 	return nil
 }
 
@@ -36,7 +41,7 @@ func (c *Command) preCheckDuplicateOptions(duplicateChecker map[string]duplicate
 			}
 		}
 
-		for _, alias := range opt.GetAliases() {
+		for _, alias := range getOptionAliases(opt) {
 			if alias != "" {
 				if key, alreadyExists := duplicateChecker[alias]; alreadyExists {
 					return errorz.Errorf("option: %s(%s) and %s(%s%s): %w", key.cmdName, key.optionFullName, c.Name, shortOptionPrefix, alias, ErrDuplicateOption)
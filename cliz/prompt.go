@@ -0,0 +1,205 @@
+package cliz
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+type (
+	// Prompter prompts the user for the input, which is used for the missing required options and the confirmation.
+	Prompter interface {
+		// IsInteractive returns whether the user can answer the prompt.
+		// If it returns false, cliz does not prompt and fails as it does without Interactive.
+		IsInteractive() bool
+		// Prompt writes message and reads a line without the trailing newline.
+		// If secret is true, the input should not be echoed.
+		Prompt(message string, secret bool) (string, error)
+	}
+
+	// terminalPrompter is the Prompter for the terminal.
+	terminalPrompter struct {
+		in     *os.File
+		out    io.Writer
+		reader *bufio.Reader
+	}
+
+	// readerPrompter is the Prompter which reads the input from io.Reader.
+	readerPrompter struct {
+		out    io.Writer
+		reader *bufio.Reader
+	}
+)
+
+// NewTerminalPrompter returns the Prompter which reads the input from the terminal in and writes the prompt to out.
+//
+// It is interactive only if in is a terminal, which `stty -g` accepts, and the CI environment variable is not set,
+// so that the non-TTY and CI runs fail fast instead of waiting for the input.
+// The secret input is read without echo by `stty -echo`.
+func NewTerminalPrompter(in *os.File, out io.Writer) Prompter {
+	return &terminalPrompter{in: in, out: out, reader: bufio.NewReader(in)}
+}
+
+func (p *terminalPrompter) IsInteractive() bool {
+	if os.Getenv("CI") != "" {
+		return false
+	}
+	return isTerminal(p.in)
+}
+
+func (p *terminalPrompter) Prompt(message string, secret bool) (string, error) {
+	_, _ = io.WriteString(p.out, message)
+
+	if secret {
+		if err := p.stty("-echo"); err != nil {
+			return "", errorz.Errorf("stty -echo: %w", err)
+		}
+		defer func() {
+			_ = p.stty("echo")
+			// NOTE: the newline typed by the user is not echoed.
+			_, _ = io.WriteString(p.out, "\n")
+		}()
+	}
+
+	return readPromptLine(p.reader)
+}
+
+func (p *terminalPrompter) stty(args ...string) error {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = p.in
+	return cmd.Run() //nolint:wrapcheck
+}
+
+// NewReaderPrompter returns the Prompter which reads the input from in and writes the prompt to out.
+//
+// It is always interactive and echoes nothing, so it is useful for the tests and the input from the pipe.
+func NewReaderPrompter(in io.Reader, out io.Writer) Prompter {
+	return &readerPrompter{out: out, reader: bufio.NewReader(in)}
+}
+
+func (p *readerPrompter) IsInteractive() bool { return true }
+
+func (p *readerPrompter) Prompt(message string, _ bool) (string, error) {
+	_, _ = io.WriteString(p.out, message)
+	return readPromptLine(p.reader)
+}
+
+func readPromptLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", errorz.Errorf("bufio.Reader.ReadString: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// isInteractive returns Interactive of the command or the nearest ancestor which enables it.
+func (c *Command) isInteractive() bool {
+	for p := c; p != nil; p = p.parent {
+		if p.Interactive {
+			return true
+		}
+	}
+	return false
+}
+
+// getPrompter returns Prompter of the command or the nearest ancestor, or DefaultPrompter.
+func (c *Command) getPrompter() Prompter {
+	for p := c; p != nil; p = p.parent {
+		if p.Prompter != nil {
+			return p.Prompter
+		}
+	}
+	return DefaultPrompter
+}
+
+// canPrompt returns whether the command can prompt the user for the input.
+func (c *Command) canPrompt() bool {
+	return c.isInteractive() && c.getPrompter().IsInteractive()
+}
+
+// promptOptionValue prompts the user for the value of the missing required option and sets it.
+func (c *Command) promptOptionValue(opt Option) error {
+	o, ok := opt.(optionValue)
+	if !ok {
+		return errorz.Errorf("%s%s: %w", longOptionPrefix, opt.GetName(), ErrInvalidOptionType)
+	}
+
+	message := fmt.Sprintf("%s%s (%s): ", longOptionPrefix, opt.GetName(), opt.GetDescription())
+	for {
+		s, err := c.getPrompter().Prompt(message, opt.IsSensitive())
+		if err != nil {
+			return errorz.Errorf("%s%s: prompt: %w", longOptionPrefix, opt.GetName(), err)
+		}
+		// NOTE: the empty input is asked again, because the option is required.
+		if s == "" {
+			continue
+		}
+
+		if err := o.setValue(s); err != nil {
+			return errorz.Errorf("%s%s: %w", longOptionPrefix, opt.GetName(), maskOptionError(opt, s, err))
+		}
+		c.setOptionSource(opt, OptionSourcePrompt)
+		return nil
+	}
+}
+
+// initAppendConfirmOption appends the option to skip the confirmation to the commands which have Confirm.
+func (c *Command) initAppendConfirmOption() {
+	if c.Confirm != "" {
+		if _, ok := c.getConfirmOption(); !ok {
+			//nolint:exhaustruct
+			c.Options = append(c.Options, &BoolOption{
+				Name:        DefaultConfirmOptionName,
+				Description: "skip the confirmation prompt",
+			})
+		}
+	}
+
+	for _, subcmd := range c.SubCommands {
+		subcmd.initAppendConfirmOption()
+	}
+}
+
+func (c *Command) getConfirmOption() (confirmOption *BoolOption, ok bool) {
+	for _, opt := range c.Options {
+		if o, ok := opt.(*BoolOption); ok && o.Name == DefaultConfirmOptionName {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// checkConfirm asks the user for the confirmation if the executed command has Confirm.
+func (c *Command) checkConfirm() error {
+	executed := c.GetExecutedCommand()
+	if executed == nil || executed.Confirm == "" {
+		return nil
+	}
+
+	if o, ok := executed.getConfirmOption(); ok && o.value != nil && *o.value {
+		return nil
+	}
+
+	if !executed.canPrompt() {
+		return errorz.Errorf("%s: specify %s%s to run non-interactively: %w", executed.Name, longOptionPrefix, DefaultConfirmOptionName, ErrNotConfirmed)
+	}
+
+	answer, err := executed.getPrompter().Prompt(executed.Confirm+" [y/N]: ", false)
+	if err != nil {
+		return errorz.Errorf("%s: prompt: %w", executed.Name, err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return errorz.Errorf("%s: %w", executed.Name, ErrNotConfirmed)
+	}
+}
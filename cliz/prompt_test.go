@@ -0,0 +1,246 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+type testPrompter struct {
+	interactive bool
+	answers     []string
+	messages    []string
+	secrets     []bool
+}
+
+func (p *testPrompter) IsInteractive() bool { return p.interactive }
+
+func (p *testPrompter) Prompt(message string, secret bool) (string, error) {
+	p.messages = append(p.messages, message)
+	p.secrets = append(p.secrets, secret)
+	if len(p.answers) == 0 {
+		return "", errors.New("no answer")
+	}
+	answer := p.answers[0]
+	p.answers = p.answers[1:]
+	return answer, nil
+}
+
+func newTestPromptCommand(prompter Prompter) *Command {
+	return &Command{
+		Name:        "main-cli",
+		Interactive: true,
+		Prompter:    prompter,
+		SubCommands: []*Command{
+			{
+				Name: "login",
+				Options: []Option{
+					&StringOption{Name: "user", Required: true, Description: "user name"},
+					&StringOption{Name: "password", Required: true, Sensitive: true, Description: "password"},
+					&Int64Option{Name: "port", Required: true, Validate: ValidateRange[int64](1, 65535)},
+				},
+			},
+			{
+				Name:    "delete",
+				Confirm: "Delete all the resources?",
+			},
+		},
+	}
+}
+
+func TestCommand_Interactive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,prompt", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: true, answers: []string{"", "alice", "secret"}}
+		c := newTestPromptCommand(p)
+		_, err := c.parse(context.Background(), []string{"main-cli", "login", "--port=22"})
+		requirez.NoError(t, err)
+
+		assertz.Equal(t, []string{"--user (user name): ", "--user (user name): ", "--password (password): "}, p.messages)
+		assertz.Equal(t, []bool{false, false, true}, p.secrets)
+
+		user, err := c.GetOptionString("user")
+		requirez.NoError(t, err)
+		assertz.Equal(t, "alice", user)
+		password, err := c.GetOptionString("password")
+		requirez.NoError(t, err)
+		assertz.Equal(t, "secret", password)
+
+		entries, err := c.GetEffectiveConfig()
+		requirez.NoError(t, err)
+		sources := make(map[string]OptionSource)
+		for _, entry := range entries {
+			sources[entry.Option.GetName()] = entry.Source
+		}
+		assertz.Equal(t, OptionSourcePrompt, sources["user"])
+		assertz.Equal(t, OptionSourceArg, sources["port"])
+	})
+
+	t.Run("error,Validate", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: true, answers: []string{"alice", "secret", "0"}}
+		c := newTestPromptCommand(p)
+		_, err := c.parse(context.Background(), []string{"main-cli", "login"})
+		requirez.ErrorIs(t, err, ErrInvalidOptionValue)
+	})
+
+	t.Run("error,ErrOptionRequired,not_interactive", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: false}
+		c := newTestPromptCommand(p)
+		_, err := c.parse(context.Background(), []string{"main-cli", "login", "--port=22"})
+		requirez.ErrorIs(t, err, ErrOptionRequired)
+		assertz.Equal(t, 0, len(p.messages))
+	})
+
+	t.Run("error,ErrOptionRequired,char_device", func(t *testing.T) {
+		t.Parallel()
+
+		devNull, err := os.Open(os.DevNull)
+		requirez.NoError(t, err)
+		t.Cleanup(func() { _ = devNull.Close() })
+
+		out := new(bytes.Buffer)
+		c := newTestPromptCommand(NewTerminalPrompter(devNull, out))
+		_, err = c.parse(context.Background(), []string{"main-cli", "login", "--user=alice", "--port=22"})
+		requirez.ErrorIs(t, err, ErrOptionRequired)
+		assertz.Equal(t, ExitCodeUsage, ExitCode(err))
+		assertz.Equal(t, "", out.String())
+	})
+
+	t.Run("error,ErrOptionRequired,Interactive_false", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: true, answers: []string{"alice", "secret"}}
+		c := newTestPromptCommand(p)
+		c.Interactive = false
+		_, err := c.parse(context.Background(), []string{"main-cli", "login", "--port=22"})
+		requirez.ErrorIs(t, err, ErrOptionRequired)
+	})
+
+	t.Run("error,prompt", func(t *testing.T) {
+		t.Parallel()
+
+		p := &testPrompter{interactive: true}
+		c := newTestPromptCommand(p)
+		_, err := c.parse(context.Background(), []string{"main-cli", "login", "--port=22"})
+		requirez.ErrorContains(t, err, "no answer")
+	})
+}
+
+func TestCommand_Confirm(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			prompter *testPrompter
+			osArgs   []string
+		}{
+			{name: "yes", prompter: &testPrompter{interactive: true, answers: []string{"Yes"}}, osArgs: []string{"main-cli", "delete"}},
+			{name: "y", prompter: &testPrompter{interactive: true, answers: []string{" y "}}, osArgs: []string{"main-cli", "delete"}},
+			{name: "--yes", prompter: &testPrompter{interactive: false}, osArgs: []string{"main-cli", "delete", "--yes"}},
+			{name: "not_executed", prompter: &testPrompter{interactive: false}, osArgs: []string{"main-cli", "login", "--user=u", "--password=p", "--port=22"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestPromptCommand(tt.prompter)
+				_, err := c.parse(context.Background(), tt.osArgs)
+				requirez.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("error,ErrNotConfirmed", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			prompter *testPrompter
+			errMsg   string
+		}{
+			{name: "no", prompter: &testPrompter{interactive: true, answers: []string{"n"}}, errMsg: "delete: not confirmed"},
+			{name: "empty", prompter: &testPrompter{interactive: true, answers: []string{""}}, errMsg: "delete: not confirmed"},
+			{name: "not_interactive", prompter: &testPrompter{interactive: false}, errMsg: "delete: specify --yes to run non-interactively: not confirmed"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				c := newTestPromptCommand(tt.prompter)
+				_, err := c.parse(context.Background(), []string{"main-cli", "delete"})
+				requirez.ErrorIs(t, err, ErrNotConfirmed)
+				requirez.ErrorContains(t, err, tt.errMsg)
+			})
+		}
+	})
+}
+
+func TestNewReaderPrompter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		out := new(bytes.Buffer)
+		p := NewReaderPrompter(strings.NewReader("foo\r\nbar"), out)
+		requirez.True(t, p.IsInteractive())
+
+		s, err := p.Prompt("first: ", false)
+		requirez.NoError(t, err)
+		assertz.Equal(t, "foo", s)
+
+		s, err = p.Prompt("second: ", true)
+		requirez.NoError(t, err)
+		assertz.Equal(t, "bar", s)
+
+		_, err = p.Prompt("third: ", false)
+		requirez.Error(t, err)
+
+		assertz.Equal(t, "first: second: third: ", out.String())
+	})
+}
+
+func TestNewTerminalPrompter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,not_terminal", func(t *testing.T) {
+		t.Parallel()
+
+		r, w, err := os.Pipe()
+		requirez.NoError(t, err)
+		t.Cleanup(func() { _ = r.Close(); _ = w.Close() })
+
+		p := NewTerminalPrompter(r, new(bytes.Buffer))
+		requirez.False(t, p.IsInteractive())
+
+		// NOTE: /dev/null is the character device, but not a terminal.
+		devNull, err := os.Open(os.DevNull)
+		requirez.NoError(t, err)
+		t.Cleanup(func() { _ = devNull.Close() })
+		requirez.False(t, isTerminal(devNull))
+		requirez.False(t, NewTerminalPrompter(devNull, new(bytes.Buffer)).IsInteractive())
+
+		_, _ = w.WriteString("foo\n")
+		s, err := p.Prompt("message: ", false)
+		requirez.NoError(t, err)
+		assertz.Equal(t, "foo", s)
+	})
+}
@@ -0,0 +1,108 @@
+package cliz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// SignalError is the cause of the context cancellation by Run when the signal is received.
+type SignalError struct {
+	// Signal is the received signal.
+	Signal os.Signal
+}
+
+var _ ExitCoder = (*SignalError)(nil)
+
+func (e *SignalError) Error() string { return "received signal: " + e.Signal.String() }
+
+// ExitCode returns 128 + the signal number, like the shell does for the process terminated by the signal.
+func (e *SignalError) ExitCode() int {
+	if s, ok := e.Signal.(syscall.Signal); ok {
+		return exitCodeSignalBase + int(s)
+	}
+	return ExitCodeError
+}
+
+//nolint:gochecknoglobals
+var (
+	// osExit is os.Exit, which is replaced in the tests.
+	osExit = os.Exit
+	// runSignals is the signals which cancel the context of Run.
+	runSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+)
+
+// Main runs the command with os.Args by Run and exits the process with the exit code.
+func Main(c *Command) {
+	osExit(Run(context.Background(), c, os.Args))
+}
+
+// Run executes the command by (*Command).Exec and returns the exit code of ExitCode.
+//
+// The context of the command is canceled with SignalError as the cause when SIGINT or SIGTERM is received,
+// and the process exits immediately when the signal is received again.
+// The error is written to Stderr() of the command, except ErrHelp and the errors which have been already shown.
+func Run(ctx context.Context, c *Command, osArgs []string) int {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, runSignals...)
+	defer signal.Stop(signals)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case sig := <-signals:
+			cancel(&SignalError{Signal: sig})
+		case <-done:
+			return
+		}
+
+		select {
+		case sig := <-signals:
+			_, _ = fmt.Fprintf(c.Stderr(), "%s: received %s again, force exit\n", c.Name, sig)
+			osExit((&SignalError{Signal: sig}).ExitCode())
+		case <-done:
+		}
+	}()
+
+	err := c.Exec(ctx, osArgs)
+
+	// NOTE: The error caused by the cancellation exits with the code of the signal.
+	var signalErr *SignalError
+	if err != nil && errors.As(context.Cause(ctx), &signalErr) && !errors.As(err, new(ExitCoder)) {
+		// NOTE: errorz.Errorf does not support multiple %w.
+		err = fmt.Errorf("%w: %w", signalErr, err)
+	}
+
+	printRunError(c.Stderr(), c.Name, err)
+
+	return ExitCode(err)
+}
+
+// printRunError writes err to w, except the errors which are already shown to the user by Exec.
+func printRunError(w io.Writer, name string, err error) {
+	if err == nil || IsHelp(err) {
+		return
+	}
+
+	// NOTE: Exec has already written the suggestion and the usage.
+	var suggestionErr *SuggestionError
+	if errors.As(err, &suggestionErr) {
+		return
+	}
+
+	// NOTE: The plugin has already written its own error.
+	var pluginExitErr *PluginExitError
+	if errors.As(err, &pluginExitErr) {
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%s: %v\n", name, err)
+}
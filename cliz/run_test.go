@@ -0,0 +1,134 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func sendTestSignal(t *testing.T, sig os.Signal) {
+	t.Helper()
+
+	p, err := os.FindProcess(os.Getpid())
+	requirez.NoError(t, err)
+	requirez.NoError(t, p.Signal(sig))
+}
+
+//nolint:paralleltest // NOTE: Run handles the signals of the process.
+func TestRun(t *testing.T) {
+	newCommand := func(execFunc func(c *Command, args []string) error) *Command {
+		return &Command{
+			Name: "main-cli",
+			SubCommands: []*Command{
+				{Name: "sub-cmd", ExecFunc: execFunc},
+			},
+		}
+	}
+
+	t.Run("success,", func(t *testing.T) {
+		c := newCommand(func(_ *Command, _ []string) error { return nil })
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, ExitCodeOK, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+		assertz.Equal(t, "", stderr.String())
+	})
+
+	t.Run("success,help", func(t *testing.T) {
+		c := newCommand(nil)
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, ExitCodeOK, Run(context.Background(), c, []string{"main-cli", "--help"}))
+		assertz.StringHasPrefix(t, stderr.String(), "Usage:\n")
+	})
+
+	t.Run("error,usage,SuggestionError", func(t *testing.T) {
+		c := newCommand(nil)
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, ExitCodeUsage, Run(context.Background(), c, []string{"main-cli", "--unknown"}))
+		// NOTE: Exec has already written the error and the usage, so Run does not write the error again.
+		assertz.StringHasPrefix(t, stderr.String(), "main-cli: unknown option \"--unknown\"\n\nUsage:\n")
+		assertz.False(t, strings.Contains(stderr.String(), "cmd = "))
+	})
+
+	t.Run("error,usage,ErrInvalidOptionValue", func(t *testing.T) {
+		for _, osArgs := range [][]string{
+			{"main-cli", "sub-cmd", "--num", "abc"},
+			{"main-cli", "sub-cmd", "--ip", "x"},
+		} {
+			c := newCommand(func(_ *Command, _ []string) error { return nil })
+			c.SubCommands[0].Options = []Option{&Int64Option{Name: "num"}, &IPOption{Name: "ip"}}
+			stderr := new(bytes.Buffer)
+			c.SetStderrRecursive(stderr)
+			assertz.Equal(t, ExitCodeUsage, Run(context.Background(), c, osArgs))
+			assertz.StringHasSuffix(t, stderr.String(), "invalid option value\n")
+		}
+	})
+
+	t.Run("error,usage", func(t *testing.T) {
+		c := newCommand(nil)
+		c.SubCommands[0].Args = []*Arg{{Name: "name", Required: true}}
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, ExitCodeUsage, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+		assertz.StringHasPrefix(t, stderr.String(), "main-cli: cmd = main-cli sub-cmd: parse: ")
+		assertz.StringHasSuffix(t, stderr.String(), "missing argument\n")
+	})
+
+	t.Run("error,ExitError", func(t *testing.T) {
+		c := newCommand(func(_ *Command, _ []string) error { return NewExitError(3, errors.New("failed")) })
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, 3, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+		assertz.Equal(t, "main-cli: cmd = main-cli sub-cmd: Exec: failed\n", stderr.String())
+	})
+
+	t.Run("error,SIGINT", func(t *testing.T) {
+		c := newCommand(func(c *Command, _ []string) error {
+			sendTestSignal(t, os.Interrupt)
+			<-c.Context().Done()
+			var signalErr *SignalError
+			requirez.True(t, errors.As(context.Cause(c.Context()), &signalErr))
+			return c.Context().Err()
+		})
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, 130, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+		assertz.Equal(t, "main-cli: received signal: interrupt: cmd = main-cli sub-cmd: Exec: context canceled\n", stderr.String())
+	})
+
+	t.Run("success,SIGINT,graceful", func(t *testing.T) {
+		c := newCommand(func(c *Command, _ []string) error {
+			sendTestSignal(t, syscall.SIGTERM)
+			<-c.Context().Done()
+			return nil
+		})
+		assertz.Equal(t, ExitCodeOK, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+	})
+
+	t.Run("error,force_exit", func(t *testing.T) {
+		exitCodes := make(chan int, 1)
+		backup := osExit
+		osExit = func(code int) { exitCodes <- code }
+		t.Cleanup(func() { osExit = backup })
+
+		c := newCommand(func(c *Command, _ []string) error {
+			sendTestSignal(t, os.Interrupt)
+			<-c.Context().Done()
+			sendTestSignal(t, syscall.SIGTERM)
+			assertz.Equal(t, 143, <-exitCodes)
+			return nil
+		})
+		stderr := new(bytes.Buffer)
+		c.SetStderrRecursive(stderr)
+		assertz.Equal(t, ExitCodeOK, Run(context.Background(), c, []string{"main-cli", "sub-cmd"}))
+		assertz.Equal(t, "main-cli: received terminated again, force exit\n", stderr.String())
+	})
+}
@@ -0,0 +1,164 @@
+package cliz
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// SuggestionError is the error for the unknown subcommand or option, which has the suggestions by edit distance.
+// Use errors.As or Suggestions to get the suggestions from the error returned by (*Command).Exec or (*Command).Parse.
+type SuggestionError struct {
+	// Err is ErrUnknownCommand or ErrUnknownOption.
+	Err error
+	// Input is the unknown argument, like `sub-cmdd` or `--fo`.
+	Input string
+	// Suggestions is the similar subcommand names or option names, like `sub-cmd` or `--foo`.
+	Suggestions []string
+}
+
+func (e *SuggestionError) Error() string {
+	msg := e.Input + ": " + e.Err.Error()
+	if len(e.Suggestions) > 0 {
+		msg += ": did you mean " + strings.Join(e.Suggestions, " or ") + "?"
+	}
+	return msg
+}
+
+func (e *SuggestionError) Unwrap() error { return e.Err }
+
+// Suggestions returns the suggestions in err, or nil if err does not have SuggestionError.
+func Suggestions(err error) []string {
+	var e *SuggestionError
+	if errors.As(err, &e) {
+		return e.Suggestions
+	}
+	return nil
+}
+
+func (c *Command) newUnknownOptionError(osArg string) *SuggestionError {
+	input := strings.TrimLeft(osArg, shortOptionPrefix)
+	if name, _, ok := strings.Cut(input, "="); ok {
+		input = name
+	}
+
+	candidates := make([]string, 0)
+	for _, opt := range c.getAcceptedOptions() {
+		if opt.IsHidden() || opt.GetDeprecated() != nil {
+			continue
+		}
+		candidates = append(candidates, optionNames(opt)...)
+	}
+
+	return &SuggestionError{
+		Err:   ErrUnknownOption,
+		Input: osArg,
+		Suggestions: suggest(input, candidates, func(candidate string) string {
+			return strings.TrimLeft(candidate, shortOptionPrefix)
+		}),
+	}
+}
+
+func (c *Command) newUnknownCommandError(arg string) *SuggestionError {
+	candidates := make([]string, 0)
+	for _, subcmd := range c.SubCommands {
+		if subcmd.Hidden || subcmd.Deprecated != nil {
+			continue
+		}
+		candidates = append(candidates, subcmd.Name)
+		candidates = append(candidates, subcmd.Aliases...)
+	}
+
+	return &SuggestionError{
+		Err:         ErrUnknownCommand,
+		Input:       arg,
+		Suggestions: suggest(arg, candidates, func(candidate string) string { return candidate }),
+	}
+}
+
+// suggest returns the candidates that are similar to input, in the order of the edit distance.
+// key returns the part of the candidate to compare with input.
+func suggest(input string, candidates []string, key func(candidate string) string) []string {
+	// NOTE: Allow one typo for the short input, and two typos for the long input.
+	const longInputLength = 6
+	maxDistance := 1
+	if len(input) >= longInputLength {
+		maxDistance = 2
+	}
+
+	type suggestion struct {
+		candidate string
+		distance  int
+	}
+	suggestions := make([]suggestion, 0)
+	for _, candidate := range candidates {
+		k := key(candidate)
+		d := editDistance(input, k)
+		// NOTE: Any single character is within one typo of another, so the single character input needs the shared prefix.
+		similar := d <= maxDistance && (utf8.RuneCountInString(input) > 1 || strings.HasPrefix(k, input))
+		if similar || (len(input) > 1 && strings.HasPrefix(k, input)) {
+			suggestions = append(suggestions, suggestion{candidate: candidate, distance: d})
+		}
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b suggestion) int { return a.distance - b.distance })
+
+	result := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		if !slices.Contains(result, s.candidate) {
+			result = append(result, s.candidate)
+		}
+	}
+	return result
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// which is the Levenshtein distance that also counts the transposition of two adjacent characters as one edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// d[i][j] is the distance between ra[:i] and rb[:j].
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// printSuggestion prints the suggestions in err to stderr, like the following:
+//
+//	main-cli: unknown command "sub-cmdd"
+//
+//	Did you mean this?
+//	    sub-cmd
+func (c *Command) printSuggestion(err *SuggestionError) {
+	msg := fmt.Sprintf("%s: %s %q\n", strings.Join(c.allExecutedCommandNames, " "), err.Err, err.Input)
+	if len(err.Suggestions) > 0 {
+		msg += "\nDid you mean this?\n"
+		for _, s := range err.Suggestions {
+			msg += "    " + s + "\n"
+		}
+	}
+	msg += "\n"
+	_, _ = io.WriteString(c.Stderr(), msg)
+}
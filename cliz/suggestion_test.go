@@ -0,0 +1,161 @@
+package cliz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func Test_editDistance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "abc", b: "", expected: 3},
+		{a: "sub-cmd", b: "sub-cmd", expected: 0},
+		{a: "sub-cmdd", b: "sub-cmd", expected: 1},
+		{a: "sbu-cmd", b: "sub-cmd", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "日本語", b: "日本", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+","+tt.b, func(t *testing.T) {
+			t.Parallel()
+
+			assertz.Equal(t, tt.expected, editDistance(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSuggestions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,nil", func(t *testing.T) {
+		t.Parallel()
+
+		assertz.Equal(t, []string(nil), Suggestions(errors.New("test")))
+	})
+}
+
+func TestCommand_parse_suggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,ErrUnknownCommand", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "sub-cmdd"})
+		requirez.ErrorIs(t, err, ErrUnknownCommand)
+		assertz.ErrorContains(t, err, "sub-cmdd: unknown command: did you mean sub-cmd or sub-cmd2 or sub-cmd3 or sub-cmd4 or sub-cmd5 or sub-cmd6?")
+		assertz.Equal(t, []string{"sub-cmd", "sub-cmd2", "sub-cmd3", "sub-cmd4", "sub-cmd5", "sub-cmd6"}, Suggestions(err))
+	})
+
+	t.Run("error,ErrUnknownCommand,alias", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", SubCommands: []*Command{{Name: "remove", Aliases: []string{"rm"}}}}
+		_, err := c.parse(context.Background(), []string{"main-cli", "mr"})
+		requirez.ErrorIs(t, err, ErrUnknownCommand)
+		assertz.Equal(t, []string{"rm"}, Suggestions(err))
+	})
+
+	t.Run("success,not_similar", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		args, err := c.parse(context.Background(), []string{"main-cli", "sub-cmd", "sub-sub-cmd", "--id", "1", "arg"})
+		requirez.NoError(t, err)
+		assertz.Equal(t, []string{"arg"}, args)
+	})
+
+	t.Run("error,ErrUnknownOption", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "--fo=bar"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.ErrorContains(t, err, "--fo=bar: unknown option: did you mean --foo?")
+		assertz.Equal(t, []string{"--foo"}, Suggestions(err))
+	})
+
+	t.Run("error,ErrUnknownOption,hidden", func(t *testing.T) {
+		t.Parallel()
+
+		c := newTestCommand()
+		_, err := c.parse(context.Background(), []string{"main-cli", "--hiden-opt"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.Equal(t, []string{}, Suggestions(err))
+	})
+
+	t.Run("error,ErrUnknownOption,POSIXStyle", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{Name: "main-cli", POSIXStyle: true, Options: []Option{&BoolOption{Name: "verbose", Aliases: []string{"v"}}, &BoolOption{Name: "x", Aliases: []string{"y"}}}}
+		_, err := c.parse(context.Background(), []string{"main-cli", "-vz"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.ErrorContains(t, err, "-z: unknown option")
+		assertz.Equal(t, []string{}, Suggestions(err))
+	})
+
+	t.Run("error,ErrUnknownOption,single_character", func(t *testing.T) {
+		t.Parallel()
+
+		newCommand := func() *Command {
+			return &Command{Name: "main-cli", POSIXStyle: true, Options: []Option{&BoolOption{Name: "verbose", Aliases: []string{"v"}}, &Int64Option{Name: "num", Aliases: []string{"n"}}, &BoolOption{Name: "xy"}}}
+		}
+		_, err := newCommand().parse(context.Background(), []string{"main-cli", "-1"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.Equal(t, []string{}, Suggestions(err))
+		assertz.Equal(t, false, strings.Contains(err.Error(), "-1: -1:"))
+
+		// NOTE: The single character input with the shared prefix is still suggested.
+		_, err = newCommand().parse(context.Background(), []string{"main-cli", "--x"})
+		requirez.ErrorIs(t, err, ErrUnknownOption)
+		assertz.Equal(t, []string{"--xy"}, Suggestions(err))
+	})
+}
+
+func TestCommand_Exec_suggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error,", func(t *testing.T) {
+		t.Parallel()
+
+		c := &Command{
+			Name: "main-cli",
+			SubCommands: []*Command{
+				{Name: "status", Description: "show status", ExecFunc: func(*Command, []string) error { return nil }},
+				{Name: "stash", Hidden: true},
+			},
+		}
+		buf := new(bytes.Buffer)
+		c.SetStderrRecursive(buf)
+		err := c.Exec(context.Background(), []string{"main-cli", "stauts"})
+		requirez.ErrorIs(t, err, ErrUnknownCommand)
+		const expected = `main-cli: unknown command "stauts"
+
+Did you mean this?
+    status
+
+Usage:
+    main-cli [options] <subcommand>
+
+Sub Commands:
+    status    show status
+
+Options:
+    --help (default: false)
+        show help message and exit
+`
+		requirez.Equal(t, expected, buf.String())
+	})
+}
@@ -0,0 +1,124 @@
+package cliz
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hakadoriya/z.go/errorz"
+)
+
+// The scalar rules of YAML shared by the config file decoder and the output encoder.
+// The encoder writes the double-quoted scalar by strconv.Quote, whose escapes are the subset of the YAML escapes.
+// The decoder supports the plain scalar, the single-quoted scalar and the double-quoted scalar with the YAML escapes,
+// but not the multi-line quoted scalars.
+
+const (
+	// yamlIndicators is the characters which cannot start the plain scalar.
+	yamlIndicators = "-?:,[]{}#&*!|>'\"%@`"
+	// yamlUnsupportedIndicators is the indicators which start the unsupported syntax,
+	// like the block scalars `|` and `>`, the anchors `&`, the aliases `*`, the tags `!` and the flow mappings `{`.
+	yamlUnsupportedIndicators = "{}&*!|>%@`"
+)
+
+// quoteYAMLScalar returns s as the plain scalar, or the double-quoted scalar if the plain scalar is not read back as s.
+func quoteYAMLScalar(s string) string {
+	if yamlNeedsQuote(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// unquoteYAMLScalar returns the string of the plain or quoted scalar s.
+func unquoteYAMLScalar(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		return unquoteYAMLDoubleQuoted(s)
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", errorz.Errorf("%s: unterminated quoted string: %w", s, ErrUnsupportedConfigFormat)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	case s != "" && strings.ContainsAny(s[:1], yamlUnsupportedIndicators):
+		return "", errorz.Errorf("%s: block scalars, anchors, aliases, tags and flow mappings are not supported: %w", s, ErrUnsupportedConfigFormat)
+	default:
+		return s, nil
+	}
+}
+
+// yamlEscapes is the single character escapes of the double-quoted scalar.
+//
+//nolint:gochecknoglobals
+var yamlEscapes = map[byte]string{
+	'0': "\x00", 'a': "\a", 'b': "\b", 't': "\t", '\t': "\t", 'n': "\n", 'v': "\v", 'f': "\f", 'r': "\r", 'e': "\x1b",
+	' ': " ", '"': `"`, '/': "/", '\\': `\`, 'N': "\u0085", '_': "\u00a0", 'L': "\u2028", 'P': "\u2029",
+}
+
+// yamlHexEscapeLengths is the number of the hex digits of the code point escapes of the double-quoted scalar.
+//
+//nolint:gochecknoglobals
+var yamlHexEscapeLengths = map[byte]int{'x': 2, 'u': 4, 'U': 8}
+
+// unquoteYAMLDoubleQuoted returns the string of the double-quoted scalar s on a line.
+func unquoteYAMLDoubleQuoted(s string) (string, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			if i != len(s)-1 {
+				return "", errorz.Errorf("%s: unexpected characters after the quoted string: %w", s, ErrUnsupportedConfigFormat)
+			}
+			return b.String(), nil
+		case '\\':
+			i++
+			if i >= len(s) {
+				break
+			}
+			if escaped, ok := yamlEscapes[s[i]]; ok {
+				b.WriteString(escaped)
+				continue
+			}
+			n, ok := yamlHexEscapeLengths[s[i]]
+			if !ok || i+n >= len(s) {
+				return "", errorz.Errorf("%s: invalid escape \\%c: %w", s, s[i], ErrUnsupportedConfigFormat)
+			}
+			r, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+			if err != nil {
+				return "", errorz.Errorf("%s: invalid escape \\%s: %w", s, s[i:i+1+n], ErrUnsupportedConfigFormat)
+			}
+			b.WriteRune(rune(r))
+			i += n
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", errorz.Errorf("%s: unterminated quoted string: %w", s, ErrUnsupportedConfigFormat)
+}
+
+// yamlNeedsQuote returns true if s is not read back as the same string when it is written as the plain scalar.
+func yamlNeedsQuote(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+
+	switch strings.ToLower(s) {
+	case "null", "~", "true", "false", "yes", "no", "on", "off", "y", "n":
+		return true
+	}
+
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+
+	if strings.ContainsAny(s[:1], yamlIndicators) {
+		return true
+	}
+
+	for _, r := range s {
+		if r < ' ' || r == 0x7f {
+			return true
+		}
+	}
+
+	return strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":")
+}
@@ -0,0 +1,84 @@
+package cliz
+
+import (
+	"testing"
+
+	"github.com/hakadoriya/z.go/testingz/assertz"
+	"github.com/hakadoriya/z.go/testingz/requirez"
+)
+
+func Test_quoteYAMLScalar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "success,plain", value: "it's a value", expected: "it's a value"},
+		{name: "success,empty", value: "", expected: `""`},
+		{name: "success,bool", value: "yes", expected: `"yes"`},
+		{name: "success,number", value: "1.5", expected: `"1.5"`},
+		{name: "success,block_scalar", value: "|", expected: `"|"`},
+		{name: "success,anchor", value: "&a", expected: `"&a"`},
+		{name: "success,comment", value: "a #b", expected: `"a #b"`},
+		{name: "success,newline", value: "a\nb", expected: `"a\nb"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := quoteYAMLScalar(tt.value)
+			assertz.Equal(t, tt.expected, actual)
+
+			// NOTE: The encoded scalar is read back as the same string.
+			unquoted, err := unquoteYAMLScalar(actual)
+			requirez.NoError(t, err)
+			assertz.Equal(t, tt.value, unquoted)
+		})
+	}
+}
+
+func Test_unquoteYAMLScalar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,double_quoted", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			value    string
+			expected string
+		}{
+			{value: `"a\tb"`, expected: "a\tb"},
+			{value: `"\e[0m"`, expected: "\x1b[0m"},
+			{value: `"\N\_\L\P"`, expected: "\u0085\u00a0\u2028\u2029"},
+			{value: `"\ \/\\\""`, expected: ` /\"`},
+			{value: `"\x41\u00e9\U0001F600"`, expected: "A\u00e9\U0001F600"},
+		}
+
+		for _, tt := range tests {
+			actual, err := unquoteYAMLScalar(tt.value)
+			requirez.NoError(t, err)
+			assertz.Equal(t, tt.expected, actual)
+		}
+	})
+
+	t.Run("error,double_quoted", func(t *testing.T) {
+		t.Parallel()
+
+		for _, s := range []string{`"a`, `"a\"`, `"\q"`, `"\x4"`, `"\uZZZZ"`, `"a" b`} {
+			_, err := unquoteYAMLScalar(s)
+			requirez.ErrorIs(t, err, ErrUnsupportedConfigFormat)
+		}
+	})
+
+	t.Run("error,unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		for _, s := range []string{"|", ">", "|-", "&a", "*a", "!!str", "{a: 1}", "@a", "`a`", "%a"} {
+			_, err := unquoteYAMLScalar(s)
+			requirez.ErrorIs(t, err, ErrUnsupportedConfigFormat)
+		}
+	})
+}
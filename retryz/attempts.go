@@ -0,0 +1,76 @@
+package retryz
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// Attempts is the summary of the attempts of Retryer.
+	Attempts struct {
+		// Count is the number of the attempts, i.e. the number of times Retry() returned true.
+		Count int
+		// Elapsed is the time elapsed since the first Retry().
+		Elapsed time.Duration
+		// Errors is the errors of the attempts observed by (*Retryer).ObserveError, in the order of the attempts.
+		Errors []error
+	}
+
+	// AttemptsError is the error returned by (*Retryer).Do when it gives up retrying.
+	//
+	// errors.Is and errors.As match Reason and each error of the attempts.
+	AttemptsError struct {
+		// Attempts is the summary of the attempts.
+		Attempts Attempts
+		// Reason is the reason why Retryer stopped retrying, like ErrMaxRetriesExceeded, ErrTimeoutExceeded and ErrUnretryableError.
+		Reason error
+	}
+)
+
+// Err returns the errors of the attempts joined by errors.Join, or nil if there is no error.
+func (a Attempts) Err() error {
+	return errors.Join(a.Errors...)
+}
+
+// LastError returns the error of the last attempt, or nil if there is no error.
+func (a Attempts) LastError() error {
+	if len(a.Errors) == 0 {
+		return nil
+	}
+	return a.Errors[len(a.Errors)-1]
+}
+
+// Error returns the message like `retryz: failed after 3 attempts over 1.5s: <reason>: <the last error>`.
+func (e *AttemptsError) Error() string {
+	s := fmt.Sprintf("retryz: failed after %d attempts over %s", e.Attempts.Count, e.Attempts.Elapsed)
+	if e.Reason != nil {
+		s += ": " + e.Reason.Error()
+	}
+	if err := e.Attempts.LastError(); err != nil {
+		s += ": " + err.Error()
+	}
+	return s
+}
+
+func (e *AttemptsError) Unwrap() []error {
+	errs := make([]error, 0, 1+len(e.Attempts.Errors))
+	if e.Reason != nil {
+		errs = append(errs, e.Reason)
+	}
+	return append(errs, e.Attempts.Errors...)
+}
+
+// Attempts returns the summary of the attempts so far.
+func (r *Retryer) Attempts() Attempts {
+	var elapsed time.Duration
+	if !r.startedAt.IsZero() {
+		elapsed = time.Since(r.startedAt)
+	}
+
+	return Attempts{
+		Count:   r.retries,
+		Elapsed: elapsed,
+		Errors:  append([]error(nil), r.errs...),
+	}
+}
@@ -0,0 +1,69 @@
+package retryz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRetryer_Attempts(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	t.Run("success,AttemptsError", func(t *testing.T) {
+		t.Parallel()
+
+		errs := []error{io.EOF, io.ErrUnexpectedEOF, io.ErrClosedPipe}
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(len(errs)-1), WithJitter(noJitter)))
+		err := r.Do(func(_ context.Context) error {
+			return errs[r.Retries()]
+		})
+
+		var attemptsErr *AttemptsError
+		if !errors.As(err, &attemptsErr) {
+			t.Fatalf("❌: err is not *AttemptsError: %v", err)
+		}
+		if attemptsErr.Attempts.Count != len(errs) {
+			t.Errorf("❌: expect(%d) != actual(%d)", len(errs), attemptsErr.Attempts.Count)
+		}
+		if attemptsErr.Attempts.Elapsed <= 0 {
+			t.Errorf("❌: elapsed <= 0: %s", attemptsErr.Attempts.Elapsed)
+		}
+		for _, target := range append([]error{ErrMaxRetriesExceeded}, errs...) {
+			if !errors.Is(err, target) {
+				t.Errorf("❌: err != `%s`: %v", target, err)
+			}
+		}
+		if actual := attemptsErr.Attempts.LastError(); !errors.Is(actual, io.ErrClosedPipe) {
+			t.Errorf("❌: err != `%s`: %v", io.ErrClosedPipe, actual)
+		}
+		const expectErr = `^retryz: failed after 3 attempts over [0-9.]+[µmn]?s: maxRetries=2: retryz: max retries exceeded: io: read/write on closed pipe$`
+		if !regexp.MustCompile(expectErr).MatchString(err.Error()) {
+			t.Errorf("❌: err not match: `%s` != `%v`", expectErr, err)
+		}
+		const expectJoined = "EOF\nunexpected EOF\nio: read/write on closed pipe"
+		if actual := attemptsErr.Attempts.Err(); actual == nil || actual.Error() != expectJoined {
+			t.Errorf("❌: expect(%s) != actual(%v)", expectJoined, actual)
+		}
+	})
+
+	t.Run("success,Retry", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(1), WithJitter(noJitter)))
+		if actual := r.Attempts(); actual.Count != 0 || actual.Elapsed != 0 || actual.Err() != nil || actual.LastError() != nil {
+			t.Errorf("❌: expect(zero) != actual(%+v)", actual)
+		}
+		for r.Retry() {
+			r.ObserveError(nil)
+		}
+		if actual := r.Attempts(); actual.Count != 2 || len(actual.Errors) != 0 {
+			t.Errorf("❌: expect(2 attempts without errors) != actual(%+v)", actual)
+		}
+	})
+
+}
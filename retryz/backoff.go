@@ -0,0 +1,170 @@
+package retryz
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+type (
+	backoffConfig struct {
+		maxInterval time.Duration
+		rnd         *rand.Rand
+	}
+	BackoffOption func(b *backoffConfig)
+)
+
+// WithBackoffMaxInterval caps the interval before randomizing it.
+// Set the same maxInterval as Config, otherwise the randomized interval over maxInterval is truncated to maxInterval,
+// and the intervals are biased toward maxInterval.
+func WithBackoffMaxInterval(maxInterval time.Duration) BackoffOption {
+	return func(b *backoffConfig) {
+		b.maxInterval = maxInterval
+	}
+}
+
+// WithBackoffRand sets the source of the randomness. The same seed yields the same intervals.
+//
+// WARNING: *rand.Rand is not safe for concurrent use, so the Backoff with it should not be used across goroutines.
+func WithBackoffRand(rnd *rand.Rand) BackoffOption {
+	return func(b *backoffConfig) {
+		b.rnd = rnd
+	}
+}
+
+func newBackoffConfig(opts ...BackoffOption) *backoffConfig {
+	b := &backoffConfig{
+		maxInterval: math.MaxInt64,
+		rnd:         nil,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// int63n returns the random value in [0, n), or 0 if n is not positive.
+func (b *backoffConfig) int63n(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	if b.rnd == nil {
+		return rand.Int63n(n) //nolint:gosec
+	}
+	return b.rnd.Int63n(n)
+}
+
+// NoJitter returns the Jitter which does not change the interval.
+// Use it with the Backoff which randomizes the interval by itself, like FullJitterBackoff.
+func NoJitter() Jitter {
+	return func(duration time.Duration) (durationWithJitter time.Duration) {
+		return duration
+	}
+}
+
+// ConstantBackoff returns the Backoff which always waits initialInterval.
+func ConstantBackoff() Backoff {
+	return func(initialInterval time.Duration, _ int) (intervalForThisRetry time.Duration) {
+		return initialInterval
+	}
+}
+
+// LinearBackoff returns the Backoff which waits initialInterval * (retries+1), like 1s, 2s, 3s, ...
+func LinearBackoff() Backoff {
+	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
+		interval := multiplyDuration(initialInterval, int64(max(retries, 0)))
+		if interval > math.MaxInt64-initialInterval {
+			return math.MaxInt64
+		}
+		return interval + initialInterval
+	}
+}
+
+// FibonacciBackoff returns the Backoff which waits initialInterval * fib(retries+1), like 1s, 1s, 2s, 3s, 5s, ...
+func FibonacciBackoff() Backoff {
+	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
+		var prev, current int64 = 0, 1
+		for range max(retries, 0) {
+			// NOTE: Stop at the overflow. The interval is already saturated.
+			if prev > math.MaxInt64-current {
+				return math.MaxInt64
+			}
+			prev, current = current, prev+current
+		}
+		return multiplyDuration(initialInterval, current)
+	}
+}
+
+// FullJitterBackoff returns the Backoff of "Full Jitter" by AWS, which waits random_between(0, min(maxInterval, initialInterval * 2^retries)).
+//
+// Use it with NoJitter:
+//
+//	c := retryz.NewConfig(10*time.Millisecond, 500*time.Millisecond,
+//		retryz.WithBackoff(retryz.FullJitterBackoff(retryz.WithBackoffMaxInterval(500*time.Millisecond))),
+//		retryz.WithJitter(retryz.NoJitter()),
+//	)
+func FullJitterBackoff(opts ...BackoffOption) Backoff {
+	b := newBackoffConfig(opts...)
+
+	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
+		interval := min(exponentialDuration(initialInterval, retries), b.maxInterval)
+		return time.Duration(b.int63n(int64(interval)))
+	}
+}
+
+// EqualJitterBackoff returns the Backoff of "Equal Jitter" by AWS, which waits the half of min(maxInterval, initialInterval * 2^retries),
+// plus random_between(0, the other half). Use it with NoJitter.
+func EqualJitterBackoff(opts ...BackoffOption) Backoff {
+	b := newBackoffConfig(opts...)
+
+	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
+		half := min(exponentialDuration(initialInterval, retries), b.maxInterval) / 2 //nolint:mnd
+		return half + time.Duration(b.int63n(int64(half)))
+	}
+}
+
+// DecorrelatedJitterBackoff returns the Backoff of "Decorrelated Jitter" by AWS,
+// which waits min(maxInterval, random_between(initialInterval, initialInterval * 3^(retries+1))). Use it with NoJitter.
+//
+// Unlike the original, which multiplies the previous interval by 3, the upper bound is derived from retries,
+// so that the Backoff has no state and Config can share it among the Retryers.
+func DecorrelatedJitterBackoff(opts ...BackoffOption) Backoff {
+	b := newBackoffConfig(opts...)
+
+	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
+		upper := initialInterval
+		for range max(retries, 0) + 1 {
+			// NOTE: Stop at maxInterval. The upper bound is already saturated.
+			if upper <= 0 || upper >= b.maxInterval {
+				break
+			}
+			upper = multiplyDuration(upper, 3) //nolint:mnd
+		}
+		upper = min(upper, b.maxInterval)
+		return min(initialInterval+time.Duration(b.int63n(int64(upper-initialInterval))), b.maxInterval)
+	}
+}
+
+// exponentialDuration returns d * 2^retries, or math.MaxInt64 on the overflow.
+func exponentialDuration(d time.Duration, retries int) time.Duration {
+	if d <= 0 || retries <= 0 {
+		return d
+	}
+	if retries >= 63 || d > math.MaxInt64>>retries { //nolint:mnd
+		return math.MaxInt64
+	}
+	return d << retries
+}
+
+// multiplyDuration returns d * n, or math.MaxInt64 on the overflow.
+func multiplyDuration(d time.Duration, n int64) time.Duration {
+	if d <= 0 || n <= 0 {
+		return 0
+	}
+	if int64(d) > math.MaxInt64/n {
+		return math.MaxInt64
+	}
+	return d * time.Duration(n)
+}
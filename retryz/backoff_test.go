@@ -0,0 +1,167 @@
+package retryz
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		backoff Backoff
+		expect  []time.Duration
+	}{
+		{name: "success,DefaultBackoff", backoff: DefaultBackoff(), expect: []time.Duration{1, 2, 4, 8, 16, 32}},
+		{name: "success,ConstantBackoff", backoff: ConstantBackoff(), expect: []time.Duration{1, 1, 1, 1, 1, 1}},
+		{name: "success,LinearBackoff", backoff: LinearBackoff(), expect: []time.Duration{1, 2, 3, 4, 5, 6}},
+		{name: "success,FibonacciBackoff", backoff: FibonacciBackoff(), expect: []time.Duration{1, 1, 2, 3, 5, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for retries, expect := range tt.expect {
+				if actual := tt.backoff(time.Second, retries); expect*time.Second != actual {
+					t.Errorf("❌: retries=%d: expect(%s) != actual(%s)", retries, expect*time.Second, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestBackoff_overflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		backoff   Backoff
+		monotonic bool
+	}{
+		{name: "success,DefaultBackoff", backoff: DefaultBackoff(), monotonic: true},
+		{name: "success,LinearBackoff", backoff: LinearBackoff(), monotonic: true},
+		{name: "success,FibonacciBackoff", backoff: FibonacciBackoff(), monotonic: true},
+		{name: "success,FullJitterBackoff", backoff: FullJitterBackoff(WithBackoffRand(rand.New(rand.NewSource(0)))), monotonic: false},
+		{name: "success,EqualJitterBackoff", backoff: EqualJitterBackoff(WithBackoffRand(rand.New(rand.NewSource(0)))), monotonic: false},
+		{name: "success,DecorrelatedJitterBackoff", backoff: DecorrelatedJitterBackoff(WithBackoffRand(rand.New(rand.NewSource(0)))), monotonic: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prev := time.Duration(0)
+			for _, retries := range []int{0, 1, 10, 62, 63, 64, 100, 1000, math.MaxInt} {
+				actual := tt.backoff(time.Hour, retries)
+				if actual < 0 {
+					t.Errorf("❌: retries=%d: overflow: %d", retries, actual)
+				}
+				if tt.monotonic && actual < prev {
+					t.Errorf("❌: retries=%d: decreased: %s < %s", retries, actual, prev)
+				}
+				prev = actual
+			}
+		})
+	}
+
+	if expect, actual := time.Duration(math.MaxInt64), DefaultBackoff()(time.Hour, 1000); expect != actual {
+		t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+	}
+}
+
+func TestBackoff_jitter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		initialInterval = 10 * time.Millisecond
+		maxInterval     = 500 * time.Millisecond
+	)
+
+	tests := []struct {
+		name    string
+		newFunc func(opts ...BackoffOption) Backoff
+		minFunc func(retries int) time.Duration
+		maxFunc func(retries int) time.Duration
+	}{
+		{
+			name:    "success,FullJitterBackoff",
+			newFunc: FullJitterBackoff,
+			minFunc: func(int) time.Duration { return 0 },
+			maxFunc: func(retries int) time.Duration {
+				return min(exponentialDuration(initialInterval, retries), maxInterval)
+			},
+		},
+		{
+			name:    "success,EqualJitterBackoff",
+			newFunc: EqualJitterBackoff,
+			minFunc: func(retries int) time.Duration {
+				return min(exponentialDuration(initialInterval, retries), maxInterval) / 2
+			},
+			maxFunc: func(retries int) time.Duration {
+				return min(exponentialDuration(initialInterval, retries), maxInterval)
+			},
+		},
+		{
+			name:    "success,DecorrelatedJitterBackoff",
+			newFunc: DecorrelatedJitterBackoff,
+			minFunc: func(int) time.Duration { return initialInterval },
+			maxFunc: func(retries int) time.Duration {
+				upper := initialInterval
+				for range retries + 1 {
+					upper = min(upper*3, maxInterval)
+				}
+				return upper
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b1 := tt.newFunc(WithBackoffMaxInterval(maxInterval), WithBackoffRand(rand.New(rand.NewSource(42))))
+			b2 := tt.newFunc(WithBackoffMaxInterval(maxInterval), WithBackoffRand(rand.New(rand.NewSource(42))))
+			for retries := range 20 {
+				actual1, actual2 := b1(initialInterval, retries), b2(initialInterval, retries)
+				if actual1 != actual2 {
+					t.Errorf("❌: retries=%d: not deterministic: %s != %s", retries, actual1, actual2)
+				}
+				if actual1 < tt.minFunc(retries) || tt.maxFunc(retries) < actual1 {
+					t.Errorf("❌: retries=%d: out of range [%s, %s]: %s", retries, tt.minFunc(retries), tt.maxFunc(retries), actual1)
+				}
+			}
+		})
+	}
+
+	t.Run("success,DecorrelatedJitterBackoff,stateless", func(t *testing.T) {
+		t.Parallel()
+
+		// NOTE: Config shares the Backoff among the Retryers, so the interval of one Retryer must not affect the others.
+		b := DecorrelatedJitterBackoff(WithBackoffMaxInterval(maxInterval), WithBackoffRand(rand.New(rand.NewSource(0))))
+		for range 100 {
+			_ = b(initialInterval, 10)
+			if actual := b(initialInterval, 0); actual < initialInterval || 3*initialInterval < actual {
+				t.Errorf("❌: out of range [%s, %s]: %s", initialInterval, 3*initialInterval, actual)
+			}
+		}
+	})
+
+	t.Run("success,Retryer", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewConfig(initialInterval, maxInterval,
+			WithBackoff(FullJitterBackoff(WithBackoffMaxInterval(maxInterval), WithBackoffRand(rand.New(rand.NewSource(0))))),
+			WithJitter(NoJitter()),
+		)
+		r := New(context.Background(), c)
+		r.Retry() // first
+		if r.RetryAfter() < 0 || initialInterval < r.RetryAfter() {
+			t.Errorf("❌: out of range [0, %s]: %s", initialInterval, r.RetryAfter())
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package retryz
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrRetryBudgetExhausted = errors.New("retryz: retry budget exhausted")
+
+// Budget is the token bucket which limits the retries across the Retryers sharing it, like the retry throttling of gRPC.
+//
+// Each failed attempt takes a token, and each successful attempt puts back tokenRatio tokens.
+// While the tokens are not more than the half of maxTokens, Retry() stops before retrying with ErrRetryBudgetExhausted.
+// The first attempt of each Retryer is never limited.
+//
+// Unlike Retryer, Budget is safe for concurrent use, so share one Budget with the Retryers for the same dependency:
+//
+//	budget := retryz.NewBudget(10, 0.1)
+//	c := retryz.NewConfig(10*time.Millisecond, 500*time.Millisecond, retryz.WithBudget(budget))
+//
+//	go func() { _ = retryz.New(ctx, c).Do(f) }()
+//	go func() { _ = retryz.New(ctx, c).Do(g) }()
+type Budget struct {
+	mu         sync.Mutex
+	maxTokens  float64
+	tokenRatio float64
+	tokens     float64
+}
+
+// NewBudget returns *Budget which has maxTokens tokens at first.
+// maxTokens less than 1 is treated as 1, and negative tokenRatio is treated as 0.
+func NewBudget(maxTokens int, tokenRatio float64) *Budget {
+	maxTokens = max(maxTokens, 1)
+	tokenRatio = max(tokenRatio, 0)
+
+	return &Budget{
+		mu:         sync.Mutex{},
+		maxTokens:  float64(maxTokens),
+		tokenRatio: tokenRatio,
+		tokens:     float64(maxTokens),
+	}
+}
+
+// Allow reports whether the retry is allowed, i.e. the tokens are more than the half of maxTokens.
+func (b *Budget) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.tokens > b.maxTokens/2 //nolint:mnd
+}
+
+// Failure takes a token for the failed attempt.
+func (b *Budget) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = max(b.tokens-1, 0)
+}
+
+// Success puts back tokenRatio tokens for the successful attempt.
+func (b *Budget) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.tokens+b.tokenRatio, b.maxTokens)
+}
+
+// Tokens returns the current tokens.
+func (b *Budget) Tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.tokens
+}
+
+func (b *Budget) String() string {
+	return fmt.Sprintf("tokens=%g/%g", b.Tokens(), b.maxTokens)
+}
+
+// WithBudget attaches budget to the Retryers built from Config.
+func WithBudget(budget *Budget) Option {
+	return func(c *Config) {
+		c.budget = budget
+	}
+}
@@ -0,0 +1,140 @@
+package retryz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewBudget(4, 0.5)
+		if !b.Allow() {
+			t.Errorf("❌: expect(true) != actual(false): %s", b)
+		}
+		b.Failure()
+		b.Failure()
+		if b.Allow() {
+			t.Errorf("❌: expect(false) != actual(true): %s", b)
+		}
+		b.Success()
+		if !b.Allow() {
+			t.Errorf("❌: expect(true) != actual(false): %s", b)
+		}
+		for range 10 {
+			b.Success()
+		}
+		if expect, actual := 4.0, b.Tokens(); expect != actual {
+			t.Errorf("❌: expect(%g) != actual(%g)", expect, actual)
+		}
+		for range 10 {
+			b.Failure()
+		}
+		if expect, actual := 0.0, b.Tokens(); expect != actual {
+			t.Errorf("❌: expect(%g) != actual(%g)", expect, actual)
+		}
+		if expect, actual := "tokens=0/4", b.String(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+	})
+
+	t.Run("success,invalid_arguments", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewBudget(0, -1)
+		if expect, actual := 1.0, b.Tokens(); expect != actual {
+			t.Errorf("❌: expect(%g) != actual(%g)", expect, actual)
+		}
+		b.Failure()
+		b.Success()
+		if expect, actual := 0.0, b.Tokens(); expect != actual {
+			t.Errorf("❌: expect(%g) != actual(%g)", expect, actual)
+		}
+	})
+}
+
+func TestRetryer_Retry_Budget(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	t.Run("success,exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewBudget(4, 0.1)
+		c := NewConfig(1*time.Microsecond, 10*time.Microsecond, WithBudget(b), WithJitter(noJitter))
+		r := New(context.Background(), c)
+		err := r.Do(func(_ context.Context) error { return io.EOF })
+		if !errors.Is(err, ErrRetryBudgetExhausted) {
+			t.Errorf("❌: err != `%s`: %v", ErrRetryBudgetExhausted, err)
+		}
+		if expect, actual := 2, r.Attempts().Count; expect != actual {
+			t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+		}
+
+		// NOTE: The first attempt is never limited, but the retry is.
+		r2 := New(context.Background(), c)
+		err = r2.Do(func(_ context.Context) error { return io.EOF })
+		if !errors.Is(err, ErrRetryBudgetExhausted) {
+			t.Errorf("❌: err != `%s`: %v", ErrRetryBudgetExhausted, err)
+		}
+		if expect, actual := 1, r2.Attempts().Count; expect != actual {
+			t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+		}
+	})
+
+	t.Run("success,refilled", func(t *testing.T) {
+		t.Parallel()
+
+		b := NewBudget(2, 1)
+		b.Failure()
+		c := NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(3), WithBudget(b), WithJitter(noJitter))
+		if err := New(context.Background(), c).Do(func(_ context.Context) error { return nil }); err != nil {
+			t.Errorf("❌: err != nil: %v", err)
+		}
+		if expect, actual := 2.0, b.Tokens(); expect != actual {
+			t.Errorf("❌: expect(%g) != actual(%g)", expect, actual)
+		}
+	})
+
+	t.Run("success,concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		const goroutines = 20
+		b := NewBudget(10, 0.1)
+		c := NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(100), WithBudget(b), WithJitter(noJitter))
+
+		var mu sync.Mutex
+		attempts := 0
+		var wg sync.WaitGroup
+		for range goroutines {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r := New(context.Background(), c)
+				err := r.Do(func(_ context.Context) error { return io.EOF })
+				if !errors.Is(err, ErrRetryBudgetExhausted) {
+					t.Errorf("❌: err != `%s`: %v", ErrRetryBudgetExhausted, err)
+				}
+				mu.Lock()
+				attempts += r.Attempts().Count
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		// NOTE: Without the budget, 20 goroutines attempt 101 times each.
+		// With the budget, the retries are limited by the tokens above the half of maxTokens,
+		// though the goroutines allowed at the same time may retry at once.
+		if attempts > 2*goroutines {
+			t.Errorf("❌: attempts(%d) > %d", attempts, 2*goroutines)
+		}
+	})
+}
@@ -0,0 +1,268 @@
+package retryz
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("retryz: circuit open")
+
+type CircuitState int
+
+const (
+	// CircuitStateClosed lets all the calls through, and counts the failures.
+	CircuitStateClosed CircuitState = iota
+	// CircuitStateOpen fails all the calls fast with ErrCircuitOpen until the cooldown passes.
+	CircuitStateOpen
+	// CircuitStateHalfOpen lets the limited calls through to probe whether the dependency recovers.
+	CircuitStateHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitStateClosed:
+		return "closed"
+	case CircuitStateOpen:
+		return "open"
+	case CircuitStateHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+type (
+	circuitBreakerConfig struct {
+		failureThreshold int
+		window           time.Duration
+		cooldown         time.Duration
+		successThreshold int
+		onStateChange    func(from, to CircuitState)
+	}
+	CircuitBreakerOption func(c *circuitBreakerConfig)
+)
+
+// WithCircuitBreakerWindow counts the failures within the sliding window.
+// If window is 0, which is the default, the consecutive failures are counted, i.e. a success resets the count.
+func WithCircuitBreakerWindow(window time.Duration) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.window = window
+	}
+}
+
+// WithCircuitBreakerSuccessThreshold sets the count of the successful probes in the half-open state to close the circuit.
+// It is also the count of the probes let through at the same time. The default is 1.
+func WithCircuitBreakerSuccessThreshold(successThreshold int) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.successThreshold = successThreshold
+	}
+}
+
+// WithCircuitBreakerOnStateChange sets the callback called on each state transition.
+// The callback is called without the lock, so it can call the methods of CircuitBreaker.
+func WithCircuitBreakerOnStateChange(f func(from, to CircuitState)) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.onStateChange = f
+	}
+}
+
+// CircuitBreaker fails the calls fast while the dependency seems down, and is safe for concurrent use.
+//
+// The circuit opens when the failures reach failureThreshold in the closed state,
+// becomes half-open after cooldown, and closes when the probes in the half-open state succeed.
+// A failed probe opens the circuit again.
+//
+// Share one CircuitBreaker for the same dependency, and pass it to (*Retryer).Do:
+//
+//	cb := retryz.NewCircuitBreaker(5, 30*time.Second, retryz.WithCircuitBreakerWindow(10*time.Second))
+//	...
+//	err := retryz.New(ctx, c).Do(f, retryz.WithCircuitBreaker(cb))
+//	if errors.Is(err, retryz.ErrCircuitOpen) {
+//		...
+//	}
+type CircuitBreaker struct {
+	mu     sync.Mutex
+	config *circuitBreakerConfig
+	// variables
+	state     CircuitState
+	failures  []time.Time
+	openedAt  time.Time
+	probes    int
+	successes int
+	// transitions is the state transitions to notify after unlocking.
+	transitions [][2]CircuitState
+}
+
+// NewCircuitBreaker returns *CircuitBreaker which opens after failureThreshold failures, and stays open for cooldown.
+// failureThreshold and the success threshold less than 1 are treated as 1.
+func NewCircuitBreaker(failureThreshold int, cooldown time.Duration, opts ...CircuitBreakerOption) *CircuitBreaker {
+	c := &circuitBreakerConfig{
+		failureThreshold: failureThreshold,
+		window:           0,
+		cooldown:         cooldown,
+		successThreshold: 1,
+		onStateChange:    nil,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.failureThreshold = max(c.failureThreshold, 1)
+	c.successThreshold = max(c.successThreshold, 1)
+
+	return &CircuitBreaker{
+		mu:          sync.Mutex{},
+		config:      c,
+		state:       CircuitStateClosed,
+		failures:    nil,
+		openedAt:    time.Time{},
+		probes:      0,
+		successes:   0,
+		transitions: nil,
+	}
+}
+
+// State returns the current state. The open circuit after the cooldown is reported as half-open.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	state := cb.refresh(time.Now())
+	transitions := cb.popTransitions()
+	cb.mu.Unlock()
+
+	cb.notify(transitions)
+	return state
+}
+
+// Allow returns nil if the call is let through, or the error which wraps ErrCircuitOpen.
+// The call let through must be reported by Record.
+func (cb *CircuitBreaker) Allow() error {
+	cb.mu.Lock()
+	cb.refresh(time.Now())
+	err := cb.allow()
+	transitions := cb.popTransitions()
+	cb.mu.Unlock()
+
+	cb.notify(transitions)
+	return err
+}
+
+func (cb *CircuitBreaker) allow() error {
+	switch cb.state {
+	case CircuitStateOpen:
+		return fmt.Errorf("state=%s: %w", cb.state, ErrCircuitOpen)
+	case CircuitStateHalfOpen:
+		if cb.probes >= cb.config.successThreshold-cb.successes {
+			return fmt.Errorf("state=%s: probes=%d: %w", cb.state, cb.probes, ErrCircuitOpen)
+		}
+		cb.probes++
+	case CircuitStateClosed:
+	}
+
+	return nil
+}
+
+// Record reports the result of the call let through by Allow. nil err is the success.
+func (cb *CircuitBreaker) Record(err error) {
+	now := time.Now()
+
+	cb.mu.Lock()
+	cb.refresh(now)
+	switch cb.state {
+	case CircuitStateClosed:
+		cb.recordClosed(now, err)
+	case CircuitStateHalfOpen:
+		cb.probes = max(cb.probes-1, 0)
+		if err != nil {
+			cb.transition(now, CircuitStateOpen)
+			break
+		}
+		cb.successes++
+		if cb.successes >= cb.config.successThreshold {
+			cb.transition(now, CircuitStateClosed)
+		}
+	case CircuitStateOpen:
+		// NOTE: The call let through before the circuit opened is finished. Nothing to do.
+	}
+	transitions := cb.popTransitions()
+	cb.mu.Unlock()
+
+	cb.notify(transitions)
+}
+
+func (cb *CircuitBreaker) recordClosed(now time.Time, err error) {
+	if err == nil {
+		if cb.config.window <= 0 {
+			cb.failures = cb.failures[:0]
+		}
+		return
+	}
+
+	cb.failures = append(cb.failures, now)
+	if cb.config.window > 0 {
+		// NOTE: Drop the failures out of the sliding window.
+		i := 0
+		for i < len(cb.failures) && now.Sub(cb.failures[i]) > cb.config.window {
+			i++
+		}
+		cb.failures = cb.failures[i:]
+	}
+
+	if len(cb.failures) >= cb.config.failureThreshold {
+		cb.transition(now, CircuitStateOpen)
+	}
+}
+
+// release gives back the call let through by Allow, which is not made, like when Retryer stops before the attempt.
+func (cb *CircuitBreaker) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitStateHalfOpen {
+		cb.probes = max(cb.probes-1, 0)
+	}
+}
+
+// refresh makes the open circuit half-open after the cooldown, and returns the state.
+func (cb *CircuitBreaker) refresh(now time.Time) CircuitState {
+	if cb.state == CircuitStateOpen && now.Sub(cb.openedAt) >= cb.config.cooldown {
+		cb.transition(now, CircuitStateHalfOpen)
+	}
+	return cb.state
+}
+
+func (cb *CircuitBreaker) transition(now time.Time, to CircuitState) {
+	cb.transitions = append(cb.transitions, [2]CircuitState{cb.state, to})
+	cb.state = to
+	cb.failures = nil
+	cb.probes = 0
+	cb.successes = 0
+	if to == CircuitStateOpen {
+		cb.openedAt = now
+	}
+}
+
+func (cb *CircuitBreaker) popTransitions() [][2]CircuitState {
+	transitions := cb.transitions
+	cb.transitions = nil
+	return transitions
+}
+
+func (cb *CircuitBreaker) notify(transitions [][2]CircuitState) {
+	if cb.config.onStateChange == nil {
+		return
+	}
+	for _, t := range transitions {
+		cb.config.onStateChange(t[0], t[1])
+	}
+}
+
+// WithCircuitBreaker lets (*Retryer).Do ask cb before waiting for each attempt, and report the result to cb.
+// If cb does not let the attempt through, Do returns the error which wraps ErrCircuitOpen without waiting for the next retry.
+func WithCircuitBreaker(cb *CircuitBreaker) DoOption {
+	return doOptionFunc(func(c *doConfig) {
+		c.circuitBreaker = cb
+	})
+}
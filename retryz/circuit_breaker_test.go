@@ -0,0 +1,239 @@
+package retryz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCircuitState_String(t *testing.T) {
+	t.Parallel()
+
+	for state, expect := range map[CircuitState]string{
+		CircuitStateClosed:   "closed",
+		CircuitStateOpen:     "open",
+		CircuitStateHalfOpen: "half-open",
+		CircuitState(99):     "CircuitState(99)",
+	} {
+		if actual := state.String(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,consecutive_failures", func(t *testing.T) {
+		t.Parallel()
+
+		var mu sync.Mutex
+		var transitions []string
+		cb := NewCircuitBreaker(2, 10*time.Millisecond, WithCircuitBreakerOnStateChange(func(from, to CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, from.String()+"->"+to.String())
+		}))
+
+		cb.Record(io.EOF)
+		cb.Record(nil) // NOTE: reset the consecutive failures
+		cb.Record(io.EOF)
+		if expect, actual := CircuitStateClosed, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+		cb.Record(io.EOF)
+		if expect, actual := CircuitStateOpen, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+		if err := cb.Allow(); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		if err := cb.Allow(); err != nil {
+			t.Errorf("❌: err != nil: %v", err)
+		}
+		// NOTE: only one probe is let through at the same time
+		if err := cb.Allow(); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+		}
+		cb.Record(io.EOF)
+		if expect, actual := CircuitStateOpen, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		if err := cb.Allow(); err != nil {
+			t.Errorf("❌: err != nil: %v", err)
+		}
+		cb.Record(nil)
+		if expect, actual := CircuitStateClosed, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		expect := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+		if len(expect) != len(transitions) {
+			t.Fatalf("❌: expect(%v) != actual(%v)", expect, transitions)
+		}
+		for i := range expect {
+			if expect[i] != transitions[i] {
+				t.Errorf("❌: expect(%v) != actual(%v)", expect, transitions)
+			}
+		}
+	})
+
+	t.Run("success,window", func(t *testing.T) {
+		t.Parallel()
+
+		cb := NewCircuitBreaker(2, time.Hour, WithCircuitBreakerWindow(10*time.Millisecond))
+		cb.Record(io.EOF)
+		time.Sleep(20 * time.Millisecond)
+		cb.Record(nil) // NOTE: a success does not reset the failures within the window
+		cb.Record(io.EOF)
+		if expect, actual := CircuitStateClosed, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+		cb.Record(io.EOF)
+		if expect, actual := CircuitStateOpen, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+	})
+
+	t.Run("success,success_threshold", func(t *testing.T) {
+		t.Parallel()
+
+		cb := NewCircuitBreaker(0, 0, WithCircuitBreakerSuccessThreshold(2))
+		cb.Record(io.EOF)
+		for range 2 {
+			if err := cb.Allow(); err != nil {
+				t.Errorf("❌: err != nil: %v", err)
+			}
+		}
+		if err := cb.Allow(); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+		}
+		cb.Record(nil)
+		if expect, actual := CircuitStateHalfOpen, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+		cb.Record(nil)
+		if expect, actual := CircuitStateClosed, cb.State(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+	})
+
+	t.Run("success,concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		// NOTE: The callback can call the methods of CircuitBreaker without the deadlock.
+		var cb *CircuitBreaker
+		cb = NewCircuitBreaker(5, time.Millisecond, WithCircuitBreakerOnStateChange(func(_, _ CircuitState) { _ = cb.State() }))
+		var wg sync.WaitGroup
+		for i := range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					if cb.Allow() != nil {
+						continue
+					}
+					if i%2 == 0 {
+						cb.Record(io.EOF)
+					} else {
+						cb.Record(nil)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestRetryer_Do_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	cb := NewCircuitBreaker(3, time.Hour)
+	c := NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(10), WithJitter(noJitter))
+
+	calls := 0
+	err := New(context.Background(), c).Do(func(_ context.Context) error {
+		calls++
+		return io.EOF
+	}, WithCircuitBreaker(cb))
+	if !errors.Is(err, ErrCircuitOpen) || !errors.Is(err, io.EOF) {
+		t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+	}
+	if expect, actual := 3, calls; expect != actual {
+		t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+	}
+
+	// NOTE: fail fast without calling f
+	r := New(context.Background(), c)
+	err = r.Do(func(_ context.Context) error {
+		calls++
+		return nil
+	}, WithCircuitBreaker(cb))
+	if !errors.Is(err, ErrCircuitOpen) || !errors.Is(r.Err(), ErrCircuitOpen) {
+		t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+	}
+	if expect, actual := 3, calls; expect != actual {
+		t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+	}
+}
+
+func TestRetryer_Do_CircuitBreaker_NoWait(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	t.Run("success,open", func(t *testing.T) {
+		t.Parallel()
+
+		cb := NewCircuitBreaker(1, time.Hour)
+		c := NewConfig(time.Hour, time.Hour, WithJitter(noJitter))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		startedAt := time.Now()
+		calls := 0
+		err := New(ctx, c).Do(func(_ context.Context) error {
+			calls++
+			return io.EOF
+		}, WithCircuitBreaker(cb))
+		if !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("❌: err != `%s`: %v", ErrCircuitOpen, err)
+		}
+		if expect, actual := 1, calls; expect != actual {
+			t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+		}
+		if elapsed := time.Since(startedAt); elapsed > time.Second {
+			t.Errorf("❌: Do waited while the circuit is open: elapsed=%s", elapsed)
+		}
+	})
+
+	t.Run("success,release", func(t *testing.T) {
+		t.Parallel()
+
+		// NOTE: The circuit becomes half-open right after it opens.
+		cb := NewCircuitBreaker(1, 0)
+		r := New(context.Background(), NewConfig(time.Millisecond, time.Millisecond, WithMaxRetries(0), WithJitter(noJitter)))
+
+		// NOTE: Retryer stops before the second attempt, so the probe let through by Allow is given back.
+		err := r.Do(func(_ context.Context) error {
+			return io.EOF
+		}, WithCircuitBreaker(cb))
+		if !errors.Is(err, ErrMaxRetriesExceeded) {
+			t.Errorf("❌: err != `%s`: %v", ErrMaxRetriesExceeded, err)
+		}
+		if err := cb.Allow(); err != nil {
+			t.Errorf("❌: cb.Allow: %v", err)
+		}
+	})
+}
@@ -57,9 +57,11 @@ func DefaultJitter(opts ...JitterOption) Jitter {
 
 type Backoff func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration)
 
+// DefaultBackoff returns the Backoff which waits initialInterval * 2^retries, like 1s, 2s, 4s, ...
+// The interval saturates at the max of time.Duration instead of overflowing for the large retries.
 func DefaultBackoff() Backoff {
 	return func(initialInterval time.Duration, retries int) (intervalForThisRetry time.Duration) {
-		return time.Duration(int64(initialInterval) << retries)
+		return exponentialDuration(initialInterval, retries)
 	}
 }
 
@@ -70,6 +72,7 @@ type Config struct {
 	timeout         time.Duration
 	backoff         Backoff
 	jitter          Jitter
+	budget          *Budget
 }
 
 const Infinite = -1
@@ -95,6 +98,7 @@ func NewConfig(initialInterval, maxInterval time.Duration, opts ...Option) *Conf
 		timeout:         0,
 		backoff:         nil,
 		jitter:          nil,
+		budget:          nil,
 	}
 
 	for _, opt := range opts {
@@ -136,9 +140,11 @@ type Retryer struct {
 	cancel context.CancelFunc
 	config *Config
 	// variables
-	interval time.Duration
-	retries  int
-	reason   error
+	interval  time.Duration
+	retries   int
+	reason    error
+	startedAt time.Time
+	errs      []error
 }
 
 func (c *Config) Build(ctx context.Context) *Retryer {
@@ -149,12 +155,14 @@ func (c *Config) Build(ctx context.Context) *Retryer {
 	}
 	copied := *c
 	return &Retryer{
-		ctx:      ctx,
-		cancel:   cancel,
-		config:   &copied,
-		interval: 0,
-		retries:  0,
-		reason:   nil,
+		ctx:       ctx,
+		cancel:    cancel,
+		config:    &copied,
+		interval:  0,
+		retries:   0,
+		reason:    nil,
+		startedAt: time.Time{},
+		errs:      nil,
 	}
 }
 
@@ -221,36 +229,58 @@ const (
 var (
 	ErrMaxRetriesExceeded = errors.New("retryz: max retries exceeded")
 	ErrTimeoutExceeded    = errors.New("retryz: timeout exceeded")
+	// ErrUnretryableError is the Reason of AttemptsError when (*Retryer).Do stops at the unretryable error.
+	ErrUnretryableError = errors.New(ErrUnretryableErrorPrefix)
 )
 
 func (r *Retryer) Retry() bool {
+	if r.startedAt.IsZero() {
+		r.startedAt = time.Now()
+	}
+
 	if 0 <= r.MaxRetries() && r.MaxRetries() <= r.Retries() {
 		r.reason = fmt.Errorf("maxRetries=%d: %w", r.config.maxRetries, ErrMaxRetriesExceeded)
 		return false
 	}
 
+	if r.retries > 0 && r.config.budget != nil && !r.config.budget.Allow() {
+		r.reason = fmt.Errorf("%s: %w", r.config.budget, ErrRetryBudgetExhausted)
+		return false
+	}
+
 	select {
 	case <-r.ctx.Done():
-		if err := r.ctx.Err(); err != nil {
-			r.reason = fmt.Errorf("ctx.Err: %w", err)
-		}
-		if err := context.Cause(r.ctx); err != nil {
-			r.reason = fmt.Errorf("%w, context.Cause: %w", r.reason, err)
-		}
-		if r.cancel != nil {
-			r.cancel()
-		}
+		r.stop()
 		return false
 	case <-time.After(r.RetryAfter()):
+		// NOTE: If the wait is capped at the deadline, the context is about to be done, so do not retry.
+		if deadline, ok := r.ctx.Deadline(); ok && !time.Now().Before(deadline) {
+			<-r.ctx.Done()
+			r.stop()
+			return false
+		}
 		r.increment()
 		return true
 	}
 }
 
+func (r *Retryer) stop() {
+	if err := r.ctx.Err(); err != nil {
+		r.reason = fmt.Errorf("ctx.Err: %w", err)
+	}
+	if err := context.Cause(r.ctx); err != nil {
+		r.reason = fmt.Errorf("%w, context.Cause: %w", r.reason, err)
+	}
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
 type doConfig struct {
 	errorHandler      func(ctx context.Context, r *Retryer, err error)
 	unretryableErrors []error
 	retryableErrors   []error
+	circuitBreaker    *CircuitBreaker
 }
 
 type DoOption interface {
@@ -289,18 +319,36 @@ func (r *Retryer) Do(f func(ctx context.Context) error, opts ...DoOption) error
 
 	var err error
 LabelRetry:
-	for r.Retry() {
+	for {
+		if c.circuitBreaker != nil {
+			// NOTE: Ask the circuit breaker before Retry() waits, so that Do does not wait while the circuit is open.
+			if err := c.circuitBreaker.Allow(); err != nil {
+				r.reason = err
+				return &AttemptsError{Attempts: r.Attempts(), Reason: err}
+			}
+		}
+		if !r.Retry() {
+			if c.circuitBreaker != nil {
+				c.circuitBreaker.release()
+			}
+			break
+		}
 		err = f(r.ctx)
+		if c.circuitBreaker != nil {
+			c.circuitBreaker.Record(err)
+		}
 		if errors.Is(err, nil) {
+			r.ObserveError(nil)
 			return nil
 		}
+		r.ObserveError(err)
 		if c.errorHandler != nil {
 			c.errorHandler(r.ctx, r, err)
 		}
 		if len(c.unretryableErrors) > 0 {
 			for _, unretryableErr := range c.unretryableErrors {
 				if errors.Is(err, unretryableErr) {
-					return fmt.Errorf(ErrUnretryableErrorPrefix+": %w", err)
+					return r.unretryable()
 				}
 			}
 			// continue LabelRetry NOTE: Do not continue here (considering the case where `c.retryableErrors` is set).
@@ -311,11 +359,37 @@ LabelRetry:
 					continue LabelRetry
 				}
 			}
-			return fmt.Errorf(ErrUnretryableErrorPrefix+": %w", err)
+			return r.unretryable()
 		}
 	}
 
-	return fmt.Errorf("%s: %w", r.Err().Error(), err)
+	return &AttemptsError{Attempts: r.Attempts(), Reason: r.Err()}
+}
+
+// unretryable stops retrying at the unretryable error, which is the last error of the attempts.
+func (r *Retryer) unretryable() error {
+	r.reason = ErrUnretryableError
+	return &AttemptsError{Attempts: r.Attempts(), Reason: r.Err()}
+}
+
+// DoValue is the same as (*Retryer).Do, but f returns the value, which is returned when f succeeds.
+//
+//	resp, err := retryz.DoValue(r, func(ctx context.Context) (*http.Response, error) {
+//		return http.DefaultClient.Do(req.WithContext(ctx))
+//	})
+func DoValue[T any](r *Retryer, f func(ctx context.Context) (T, error), opts ...DoOption) (T, error) {
+	var v T
+	err := r.Do(func(ctx context.Context) error {
+		var err error
+		v, err = f(ctx)
+		return err
+	}, opts...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return v, nil
 }
 
 func (r *Retryer) getInitialInterval() time.Duration {
@@ -0,0 +1,214 @@
+package retryz
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryAfterError is the error which carries the delay hint from the server, like Retry-After of HTTP and RetryInfo of gRPC.
+//
+// If the error returned by the function of (*Retryer).Do wraps RetryAfterError,
+// the next Retry() waits at least RetryAfter(), capped by maxInterval and the timeout.
+type RetryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+type retryAfterError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return fmt.Sprintf("retryAfter=%s: %v", e.retryAfter, e.err)
+}
+
+func (e *retryAfterError) Unwrap() error { return e.err }
+
+func (e *retryAfterError) RetryAfter() time.Duration { return e.retryAfter }
+
+// NewRetryAfterError returns the error which wraps err and carries retryAfter as RetryAfterError.
+//
+// Is used as follows:
+//
+//	resp, err := http.DefaultClient.Do(req)
+//	...
+//	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+//		if retryAfter, ok := retryz.RetryAfterFromHTTPResponse(resp); ok {
+//			return retryz.NewRetryAfterError(fmt.Errorf("status=%d", resp.StatusCode), retryAfter)
+//		}
+//	}
+func NewRetryAfterError(err error, retryAfter time.Duration) error {
+	return &retryAfterError{err: err, retryAfter: retryAfter}
+}
+
+// ObserveError records err as the error of the current attempt for Attempts() and Budget,
+// and extends the wait before the next Retry() to the delay hint carried by err, if any.
+// The hint is capped by maxInterval and the remaining time until the timeout.
+// nil err records the successful attempt for Budget. (*Retryer).Do calls it for each attempt.
+//
+//	for r.Retry() {
+//		err := f(ctx)
+//		r.ObserveError(err)
+//		if err != nil {
+//			continue
+//		}
+//		break
+//	}
+func (r *Retryer) ObserveError(err error) {
+	if err == nil {
+		if r.config.budget != nil {
+			r.config.budget.Success()
+		}
+		return
+	}
+	r.errs = append(r.errs, err)
+	if r.config.budget != nil {
+		r.config.budget.Failure()
+	}
+
+	retryAfter, ok := RetryAfterFromError(err)
+	if !ok || retryAfter <= r.interval {
+		return
+	}
+
+	r.interval = r.truncateAtMaxInterval(retryAfter)
+	if deadline, ok := r.ctx.Deadline(); ok {
+		// NOTE: waiting beyond the deadline is useless, because Retry() returns false at the deadline.
+		if remaining := time.Until(deadline); r.interval > remaining {
+			r.interval = max(remaining, 0)
+		}
+	}
+}
+
+// RetryAfterFromError returns the delay hint carried by err.
+// It supports RetryAfterError and the gRPC status error with errdetails.RetryInfo.
+func RetryAfterFromError(err error) (retryAfter time.Duration, ok bool) {
+	var retryAfterErr RetryAfterError
+	if errors.As(err, &retryAfterErr) {
+		return retryAfterErr.RetryAfter(), true
+	}
+
+	return RetryAfterFromGRPCError(err)
+}
+
+// RetryAfterFromHTTPResponse returns the delay of the Retry-After header of resp,
+// which is either the delay seconds or the HTTP date.
+func RetryAfterFromHTTPResponse(resp *http.Response) (retryAfter time.Duration, ok bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	return parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+}
+
+func parseRetryAfter(value string, now time.Time) (retryAfter time.Duration, ok bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		// NOTE: avoid the overflow of time.Duration for the huge value.
+		if seconds > int64(math.MaxInt64/time.Second) {
+			return math.MaxInt64, true
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if retryAfter := date.Sub(now); retryAfter > 0 {
+		return retryAfter, true
+	}
+	return 0, true
+}
+
+// RetryAfterFromGRPCError returns the retry delay of errdetails.RetryInfo in the details of the gRPC status error.
+//
+// To avoid the dependency on google.golang.org/grpc, the status is found by the methods in the same way as status.FromError,
+// i.e. `GRPCStatus() *status.Status`, `(*status.Status).Details() []any`, `(*errdetails.RetryInfo).GetRetryDelay() *durationpb.Duration`
+// and `(*durationpb.Duration).AsDuration() time.Duration`.
+func RetryAfterFromGRPCError(err error) (retryAfter time.Duration, ok bool) {
+	status, found := findGRPCStatus(err)
+	if !found {
+		return 0, false
+	}
+
+	details, found := callMethod(status, "Details")
+	if !found || details.Kind() != reflect.Slice {
+		return 0, false
+	}
+
+	for i := range details.Len() {
+		retryDelay, found := callMethod(details.Index(i), "GetRetryDelay")
+		if !found {
+			continue
+		}
+		if d, ok := retryDelay.Interface().(interface{ AsDuration() time.Duration }); ok {
+			return d.AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
+
+// findGRPCStatus returns the result of GRPCStatus() of the first error in the tree of err which has the method.
+func findGRPCStatus(err error) (status reflect.Value, ok bool) {
+	if err == nil {
+		return reflect.Value{}, false
+	}
+
+	if status, ok := callMethod(reflect.ValueOf(err), "GRPCStatus"); ok {
+		return status, true
+	}
+
+	switch e := err.(type) { //nolint:errorlint
+	case interface{ Unwrap() error }:
+		return findGRPCStatus(e.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			if status, ok := findGRPCStatus(err); ok {
+				return status, true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// callMethod calls the method name of v without arguments, and returns the result if it returns the single non-nil value.
+func callMethod(v reflect.Value, name string) (result reflect.Value, ok bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	method := v.MethodByName(name)
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return reflect.Value{}, false
+	}
+
+	result = method.Call(nil)[0]
+	//nolint:exhaustive
+	switch result.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		if result.IsNil() {
+			return reflect.Value{}, false
+		}
+	}
+	return result, true
+}
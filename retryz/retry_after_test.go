@@ -0,0 +1,176 @@
+package retryz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// The following types imitate *durationpb.Duration, *errdetails.RetryInfo, *status.Status and the gRPC status error.
+type (
+	testGRPCDuration  struct{ d time.Duration }
+	testGRPCRetryInfo struct{ delay *testGRPCDuration }
+	testGRPCStatus    struct{ details []any }
+	testGRPCError     struct{ status *testGRPCStatus }
+)
+
+func (d *testGRPCDuration) AsDuration() time.Duration         { return d.d }
+func (i *testGRPCRetryInfo) GetRetryDelay() *testGRPCDuration { return i.delay }
+func (s *testGRPCStatus) Details() []any                      { return s.details }
+func (e *testGRPCError) Error() string                        { return "rpc error: code = Unavailable" }
+func (e *testGRPCError) GRPCStatus() *testGRPCStatus          { return e.status }
+
+func TestRetryAfterFromHTTPResponse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		value      string
+		retryAfter time.Duration
+		ok         bool
+	}{
+		{name: "success,seconds", value: "120", retryAfter: 120 * time.Second, ok: true},
+		{name: "success,date", value: now.Add(30 * time.Second).Format(http.TimeFormat), retryAfter: 30 * time.Second, ok: true},
+		{name: "success,past_date", value: now.Add(-30 * time.Second).Format(http.TimeFormat), retryAfter: 0, ok: true},
+		{name: "success,overflow", value: "99999999999999999", retryAfter: time.Duration(1<<63 - 1), ok: true},
+		{name: "failure,empty", value: "", retryAfter: 0, ok: false},
+		{name: "failure,negative", value: "-1", retryAfter: 0, ok: false},
+		{name: "failure,invalid", value: "soon", retryAfter: 0, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryAfter, ok := parseRetryAfter(tt.value, now)
+			if retryAfter != tt.retryAfter || ok != tt.ok {
+				t.Errorf("❌: expect(%s, %t) != actual(%s, %t)", tt.retryAfter, tt.ok, retryAfter, ok)
+			}
+		})
+	}
+
+	t.Run("success,http.Response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3"}}}
+		if retryAfter, ok := RetryAfterFromHTTPResponse(resp); retryAfter != 3*time.Second || !ok {
+			t.Errorf("❌: expect(3s, true) != actual(%s, %t)", retryAfter, ok)
+		}
+		if retryAfter, ok := RetryAfterFromHTTPResponse(nil); retryAfter != 0 || ok {
+			t.Errorf("❌: expect(0s, false) != actual(%s, %t)", retryAfter, ok)
+		}
+	})
+}
+
+func TestRetryAfterFromError(t *testing.T) {
+	t.Parallel()
+
+	grpcErr := &testGRPCError{status: &testGRPCStatus{details: []any{"other detail", &testGRPCRetryInfo{delay: &testGRPCDuration{d: 2 * time.Second}}}}}
+	tests := []struct {
+		name       string
+		err        error
+		retryAfter time.Duration
+		ok         bool
+	}{
+		{name: "success,RetryAfterError", err: fmt.Errorf("wrapped: %w", NewRetryAfterError(io.EOF, time.Second)), retryAfter: time.Second, ok: true},
+		{name: "success,gRPC", err: grpcErr, retryAfter: 2 * time.Second, ok: true},
+		{name: "success,gRPC_wrapped", err: fmt.Errorf("wrapped: %w", grpcErr), retryAfter: 2 * time.Second, ok: true},
+		{name: "success,gRPC_joined", err: errors.Join(io.EOF, grpcErr), retryAfter: 2 * time.Second, ok: true},
+		{name: "failure,gRPC_without_RetryInfo", err: &testGRPCError{status: &testGRPCStatus{details: []any{&testGRPCRetryInfo{delay: nil}}}}, retryAfter: 0, ok: false},
+		{name: "failure,gRPC_nil_status", err: &testGRPCError{status: nil}, retryAfter: 0, ok: false},
+		{name: "failure,no_hint", err: io.EOF, retryAfter: 0, ok: false},
+		{name: "failure,nil", err: nil, retryAfter: 0, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			retryAfter, ok := RetryAfterFromError(tt.err)
+			if retryAfter != tt.retryAfter || ok != tt.ok {
+				t.Errorf("❌: expect(%s, %t) != actual(%s, %t)", tt.retryAfter, tt.ok, retryAfter, ok)
+			}
+		})
+	}
+
+	t.Run("success,Error", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewRetryAfterError(io.EOF, time.Second)
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("❌: err != `%s`: %v", io.EOF, err)
+		}
+		const expect = "retryAfter=1s: EOF"
+		if actual := err.Error(); expect != actual {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, actual)
+		}
+	})
+}
+
+func TestRetryer_ObserveError(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	t.Run("success,Do", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 50*time.Millisecond, WithMaxRetries(1), WithJitter(noJitter)))
+		retryAfters := make([]time.Duration, 0)
+		err := r.Do(func(_ context.Context) error {
+			return NewRetryAfterError(io.EOF, 20*time.Millisecond)
+		}, WithErrorHandler(func(_ context.Context, r *Retryer, _ error) {
+			retryAfters = append(retryAfters, r.RetryAfter())
+		}))
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("❌: err != `%s`: %v", io.EOF, err)
+		}
+		if len(retryAfters) != 2 || retryAfters[0] != 20*time.Millisecond {
+			t.Errorf("❌: expect([20ms ...]) != actual(%v)", retryAfters)
+		}
+	})
+
+	t.Run("success,maxInterval", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 5*time.Millisecond, WithJitter(noJitter)))
+		r.Retry()
+		r.ObserveError(NewRetryAfterError(io.EOF, time.Minute))
+		if actual := r.RetryAfter(); actual != 5*time.Millisecond {
+			t.Errorf("❌: expect(5ms) != actual(%s)", actual)
+		}
+	})
+
+	t.Run("success,timeout", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, time.Hour, WithTimeout(10*time.Millisecond), WithJitter(noJitter)))
+		r.Retry()
+		r.ObserveError(NewRetryAfterError(io.EOF, time.Minute))
+		if actual := r.RetryAfter(); actual <= 0 || 10*time.Millisecond < actual {
+			t.Errorf("❌: expect(0 < retryAfter <= 10ms) != actual(%s)", actual)
+		}
+		if r.Retry() {
+			t.Errorf("❌: Retry() == true")
+		}
+		if !errors.Is(r.Err(), ErrTimeoutExceeded) {
+			t.Errorf("❌: err != `%s`: %v", ErrTimeoutExceeded, r.Err())
+		}
+	})
+
+	t.Run("success,shorter_hint", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(10*time.Millisecond, time.Hour, WithJitter(noJitter)))
+		r.Retry()
+		r.ObserveError(NewRetryAfterError(io.EOF, time.Millisecond))
+		if actual := r.RetryAfter(); actual != 10*time.Millisecond {
+			t.Errorf("❌: expect(10ms) != actual(%s)", actual)
+		}
+	})
+}
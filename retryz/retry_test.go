@@ -264,6 +264,16 @@ func TestRetryer_Do(t *testing.T) {
 		if !strings.Contains(err.Error(), expectErr) {
 			t.Errorf("❌: err not contain: `%s` != `%v`", expectErr, err)
 		}
+		var attemptsErr *AttemptsError
+		if !errors.As(err, &attemptsErr) {
+			t.Fatalf("❌: err is not *AttemptsError: %v", err)
+		}
+		if attemptsErr.Attempts.Count != 1 {
+			t.Errorf("❌: attemptsErr.Attempts.Count: expect(1) != actual(%d)", attemptsErr.Attempts.Count)
+		}
+		if !errors.Is(err, ErrUnretryableError) || !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("❌: err is not ErrUnretryableError and io.ErrUnexpectedEOF: %v", err)
+		}
 		const expect = `retries=0/20 retryAfter=8.166505ms; `
 		actual := buf.String()
 		if expect != actual {
@@ -293,6 +303,16 @@ func TestRetryer_Do(t *testing.T) {
 		if !strings.Contains(err.Error(), expectErr) {
 			t.Errorf("❌: err not contain: `%s` != `%v`", expectErr, err)
 		}
+		var attemptsErr *AttemptsError
+		if !errors.As(err, &attemptsErr) {
+			t.Fatalf("❌: err is not *AttemptsError: %v", err)
+		}
+		if attemptsErr.Attempts.Count != 1 {
+			t.Errorf("❌: attemptsErr.Attempts.Count: expect(1) != actual(%d)", attemptsErr.Attempts.Count)
+		}
+		if !errors.Is(err, ErrUnretryableError) || !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("❌: err is not ErrUnretryableError and io.ErrUnexpectedEOF: %v", err)
+		}
 		const expect = `retries=0/20 retryAfter=8.166505ms; `
 		actual := buf.String()
 		if expect != actual {
@@ -301,3 +321,45 @@ func TestRetryer_Do(t *testing.T) {
 		t.Logf("✅: actual: %s", buf)
 	})
 }
+
+func TestDoValue(t *testing.T) {
+	t.Parallel()
+
+	noJitter := func(d time.Duration) time.Duration { return d }
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(5), WithJitter(noJitter)))
+		v, err := DoValue(r, func(_ context.Context) (string, error) {
+			if r.Retries() < 2 {
+				return "partial", io.ErrUnexpectedEOF
+			}
+			return "ok", nil
+		})
+		if err != nil {
+			t.Errorf("❌: err != nil: %v", err)
+		}
+		if expect := "ok"; expect != v {
+			t.Errorf("❌: expect(%s) != actual(%s)", expect, v)
+		}
+		if expect, actual := 3, r.Attempts().Count; expect != actual {
+			t.Errorf("❌: expect(%d) != actual(%d)", expect, actual)
+		}
+	})
+
+	t.Run("failure,", func(t *testing.T) {
+		t.Parallel()
+
+		r := New(context.Background(), NewConfig(1*time.Microsecond, 10*time.Microsecond, WithMaxRetries(1), WithJitter(noJitter)))
+		v, err := DoValue(r, func(_ context.Context) (int, error) {
+			return 1, io.ErrUnexpectedEOF
+		}, WithRetryableErrors(io.ErrUnexpectedEOF))
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("❌: err != `%s`: %v", io.ErrUnexpectedEOF, err)
+		}
+		if v != 0 {
+			t.Errorf("❌: expect(0) != actual(%d)", v)
+		}
+	})
+}